@@ -14,9 +14,11 @@ import (
 )
 
 var (
-	md_Config                   protoreflect.MessageDescriptor
-	fd_Config_skip_ante_handler protoreflect.FieldDescriptor
-	fd_Config_skip_post_handler protoreflect.FieldDescriptor
+	md_Config                            protoreflect.MessageDescriptor
+	fd_Config_skip_ante_handler          protoreflect.FieldDescriptor
+	fd_Config_skip_post_handler          protoreflect.FieldDescriptor
+	fd_Config_refund_unused_fees         protoreflect.FieldDescriptor
+	fd_Config_refund_min_charge_fraction protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +26,8 @@ func init() {
 	md_Config = File_cosmos_tx_config_v1_config_proto.Messages().ByName("Config")
 	fd_Config_skip_ante_handler = md_Config.Fields().ByName("skip_ante_handler")
 	fd_Config_skip_post_handler = md_Config.Fields().ByName("skip_post_handler")
+	fd_Config_refund_unused_fees = md_Config.Fields().ByName("refund_unused_fees")
+	fd_Config_refund_min_charge_fraction = md_Config.Fields().ByName("refund_min_charge_fraction")
 }
 
 var _ protoreflect.Message = (*fastReflection_Config)(nil)
@@ -103,6 +107,18 @@ func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.RefundUnusedFees != false {
+		value := protoreflect.ValueOfBool(x.RefundUnusedFees)
+		if !f(fd_Config_refund_unused_fees, value) {
+			return
+		}
+	}
+	if x.RefundMinChargeFraction != "" {
+		value := protoreflect.ValueOfString(x.RefundMinChargeFraction)
+		if !f(fd_Config_refund_min_charge_fraction, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +138,10 @@ func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SkipAnteHandler != false
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		return x.SkipPostHandler != false
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		return x.RefundUnusedFees != false
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		return x.RefundMinChargeFraction != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -142,6 +162,10 @@ func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
 		x.SkipAnteHandler = false
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		x.SkipPostHandler = false
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		x.RefundUnusedFees = false
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		x.RefundMinChargeFraction = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -164,6 +188,12 @@ func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		value := x.SkipPostHandler
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		value := x.RefundUnusedFees
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		value := x.RefundMinChargeFraction
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -188,6 +218,10 @@ func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SkipAnteHandler = value.Bool()
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		x.SkipPostHandler = value.Bool()
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		x.RefundUnusedFees = value.Bool()
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		x.RefundMinChargeFraction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -212,6 +246,10 @@ func (x *fastReflection_Config) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field skip_ante_handler of message cosmos.tx.config.v1.Config is not mutable"))
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		panic(fmt.Errorf("field skip_post_handler of message cosmos.tx.config.v1.Config is not mutable"))
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		panic(fmt.Errorf("field refund_unused_fees of message cosmos.tx.config.v1.Config is not mutable"))
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		panic(fmt.Errorf("field refund_min_charge_fraction of message cosmos.tx.config.v1.Config is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -229,6 +267,10 @@ func (x *fastReflection_Config) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.tx.config.v1.Config.refund_unused_fees":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.tx.config.v1.Config.refund_min_charge_fraction":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -304,6 +346,13 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 		if x.SkipPostHandler {
 			n += 2
 		}
+		if x.RefundUnusedFees {
+			n += 2
+		}
+		l = len(x.RefundMinChargeFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -333,6 +382,23 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.RefundMinChargeFraction) > 0 {
+			i -= len(x.RefundMinChargeFraction)
+			copy(dAtA[i:], x.RefundMinChargeFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RefundMinChargeFraction)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.RefundUnusedFees {
+			i--
+			if x.RefundUnusedFees {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.SkipPostHandler {
 			i--
 			if x.SkipPostHandler {
@@ -442,6 +508,58 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.SkipPostHandler = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundUnusedFees", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RefundUnusedFees = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundMinChargeFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundMinChargeFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -502,6 +620,12 @@ type Config struct {
 	// skip_post_handler defines whether the post handler registration should be skipped in case an app wants to override
 	// this functionality.
 	SkipPostHandler bool `protobuf:"varint,2,opt,name=skip_post_handler,json=skipPostHandler,proto3" json:"skip_post_handler,omitempty"`
+	// refund_unused_fees defines whether the post handler refunds the share of the fee paying for the gas a tx did not
+	// use.
+	RefundUnusedFees bool `protobuf:"varint,3,opt,name=refund_unused_fees,json=refundUnusedFees,proto3" json:"refund_unused_fees,omitempty"`
+	// refund_min_charge_fraction defines the minimum fraction of the fee charged when refunding unused fees, as a
+	// decimal in [0, 1]. Zero if empty.
+	RefundMinChargeFraction string `protobuf:"bytes,4,opt,name=refund_min_charge_fraction,json=refundMinChargeFraction,proto3" json:"refund_min_charge_fraction,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -538,6 +662,20 @@ func (x *Config) GetSkipPostHandler() bool {
 	return false
 }
 
+func (x *Config) GetRefundUnusedFees() bool {
+	if x != nil {
+		return x.RefundUnusedFees
+	}
+	return false
+}
+
+func (x *Config) GetRefundMinChargeFraction() string {
+	if x != nil {
+		return x.RefundMinChargeFraction
+	}
+	return ""
+}
+
 var File_cosmos_tx_config_v1_config_proto protoreflect.FileDescriptor
 
 var file_cosmos_tx_config_v1_config_proto_rawDesc = []byte{
@@ -546,29 +684,36 @@ var file_cosmos_tx_config_v1_config_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x06, 0x43, 0x6f,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x06, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x61, 0x6e, 0x74,
 	0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x0f, 0x73, 0x6b, 0x69, 0x70, 0x41, 0x6e, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
 	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x61,
 	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69,
-	0x70, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3a, 0x2e, 0xba, 0xc0,
-	0x96, 0xda, 0x01, 0x28, 0x0a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x78, 0x42, 0xc4, 0x01, 0x0a,
-	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x74, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x43, 0xaa, 0x02, 0x13, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x2e, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x28, 0x0a,
+	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x78, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x43, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x54, 0x78, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x54, 0x78, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -185,16 +185,22 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	gasMeter := app.getBlockGasMeter(app.deliverState.ctx)
 
+	// The gas schedule of the KVStores is loaded once per block, the txs of the
+	// block inherit it from the DeliverTx and CheckTx contexts.
+	gasSchedule := app.GetGasSchedule(app.deliverState.ctx)
+
 	app.deliverState.ctx = app.deliverState.ctx.
 		WithBlockGasMeter(gasMeter).
 		WithHeaderHash(req.Hash).
 		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx)).
+		WithKVGasSchedule(gasSchedule).
 		WithVoteInfos(req.LastCommitInfo.GetVotes())
 
 	if app.checkState != nil {
 		app.checkState.ctx = app.checkState.ctx.
 			WithBlockGasMeter(gasMeter).
-			WithHeaderHash(req.Hash)
+			WithHeaderHash(req.Hash).
+			WithKVGasSchedule(gasSchedule)
 	}
 
 	if app.beginBlocker != nil {
@@ -287,7 +293,8 @@ func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.
 
 	app.prepareProposalState.ctx = app.prepareProposalState.ctx.
 		WithConsensusParams(app.GetConsensusParams(app.prepareProposalState.ctx)).
-		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.ctx))
+		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.ctx)).
+		WithKVGasSchedule(app.GetGasSchedule(app.prepareProposalState.ctx))
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -345,7 +352,8 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 
 	app.processProposalState.ctx = app.processProposalState.ctx.
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.ctx)).
-		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.ctx))
+		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.ctx)).
+		WithKVGasSchedule(app.GetGasSchedule(app.processProposalState.ctx))
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -484,6 +492,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// NOTE: This is safe because CometBFT holds a lock on the mempool for
 	// Commit. Use the header from this latest block.
 	app.setState(runTxModeCheck, header)
+	app.checkState.ctx = app.checkState.ctx.WithKVGasSchedule(app.deliverState.ctx.KVGasSchedule())
 
 	// empty/reset the deliver state
 	app.deliverState = nil
@@ -713,9 +722,17 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	res, err := handler(ctx, req)
+	var res abci.ResponseQuery
+	err = app.runQuery(ctx, req.Path, func(ctx sdk.Context) (err error) {
+		res, err = handler(ctx, req)
+		return err
+	})
 	if err != nil {
-		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
+		if !errors.Is(err, sdkerrors.ErrOutOfGas) && !errors.Is(err, sdkerrors.ErrQueryTimeout) {
+			err = gRPCErrorToSDKError(err)
+		}
+
+		res = sdkerrors.QueryResult(err, app.trace)
 		res.Height = req.Height
 		return res
 	}
@@ -807,6 +824,8 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		WithMinGasPrices(app.minGasPrices).
 		WithBlockHeight(height)
 
+	ctx = ctx.WithKVGasSchedule(app.GetGasSchedule(ctx))
+
 	return ctx, nil
 }
 
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,6 +17,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -28,6 +30,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -645,6 +648,39 @@ func TestABCI_CheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestABCI_CheckTx_MempoolRejection(t *testing.T) {
+	// The ante handler increments the counter, the state changes of a tx
+	// rejected by the mempool must not be written to the CheckTx state.
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	pool := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1))
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	r := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+
+	// the mempool is full, so the next tx is rejected after the ante handler ran
+	tx = newTxCounter(t, suite.txConfig, 1, 0)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	r = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Contains(t, r.Log, mempool.ErrMempoolTxMaxCapacity.Error())
+
+	checkStateStore := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(t, checkStateStore, counterKey))
+	require.Equal(t, 1, pool.CountTx())
+}
+
 func TestABCI_DeliverTx(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -815,6 +851,163 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateOnBranch(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.Commit()
+
+	header := cmtproto.Header{Height: 2}
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// the state changes of the tx are written to the branch
+	branch := suite.baseApp.CommitMultiStore().CacheMultiStore()
+	gInfo, result, err := suite.baseApp.SimulateOnBranch(branch, header, txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotZero(t, gInfo.GasUsed)
+	require.Equal(t, int64(1), getIntFromStore(t, branch.GetKVStore(capKey1), anteKey))
+	require.Equal(t, int64(1), getIntFromStore(t, branch.GetKVStore(capKey1), deliverKey))
+
+	// the committed state is left untouched
+	committed := suite.baseApp.CommitMultiStore().GetKVStore(capKey1)
+	require.Nil(t, committed.Get(anteKey))
+	require.Nil(t, committed.Get(deliverKey))
+
+	// only the AnteHandler changes are written when a message fails
+	tx = setFailOnHandler(suite.txConfig, tx, true)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	branch = suite.baseApp.CommitMultiStore().CacheMultiStore()
+	_, result, err = suite.baseApp.SimulateOnBranch(branch, header, txBytes)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Equal(t, int64(1), getIntFromStore(t, branch.GetKVStore(capKey1), anteKey))
+	require.Nil(t, branch.GetKVStore(capKey1).Get(deliverKey))
+}
+
+// stateOverrider records the account overrides of SimulateBundle.
+type stateOverrider struct {
+	balances  map[string]sdk.Coins
+	sequences map[string]uint64
+}
+
+func (so *stateOverrider) OverrideBalances(_ sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	so.balances[addr.String()] = balances
+	return nil
+}
+
+func (so *stateOverrider) OverrideSequence(_ sdk.Context, addr sdk.AccAddress, sequence uint64) error {
+	so.sequences[addr.String()] = sequence
+	return nil
+}
+
+func TestABCI_SimulateBundle(t *testing.T) {
+	anteKey := []byte("ante-key")
+	var simulated []bool
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			simulated = append(simulated, simulate)
+			return anteHandler(ctx, tx, simulate)
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.Commit()
+
+	encode := func(tx signing.Tx) []byte {
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// each tx sees the changes of the previous ones, and the AnteHandler
+	// changes of a failed tx are kept
+	txs := [][]byte{
+		encode(newTxCounter(t, suite.txConfig, 0, 0)),
+		encode(setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 1, 1), true)),
+		encode(newTxCounter(t, suite.txConfig, 2, 1)),
+	}
+	results, err := suite.baseApp.SimulateBundle(txs, txtypes.StateOverrides{}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	require.NotZero(t, results[0].GasInfo.GasUsed)
+	require.NotEmpty(t, results[0].Result.Events)
+	require.ErrorContains(t, results[1].Err, "message handler failure")
+	require.Nil(t, results[1].Result)
+	require.NoError(t, results[2].Err)
+	require.Equal(t, []bool{true, true, true}, simulated)
+
+	// the check state is left untouched
+	_, _, err = suite.baseApp.Simulate(txs[0])
+	require.NoError(t, err)
+
+	// the signatures are verified on request
+	simulated = nil
+	results, err = suite.baseApp.SimulateBundle(txs[:1], txtypes.StateOverrides{}, true)
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, []bool{false}, simulated)
+
+	// the store overrides are applied before the txs
+	counter := binary.AppendVarint(nil, 5)
+	overrides := txtypes.StateOverrides{
+		Stores: []txtypes.StoreOverride{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: counter},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Value: counter},
+		},
+	}
+	results, err = suite.baseApp.SimulateBundle([][]byte{encode(newTxCounter(t, suite.txConfig, 5, 5))}, overrides, false)
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+
+	_, err = suite.baseApp.SimulateBundle(txs, txtypes.StateOverrides{
+		Stores: []txtypes.StoreOverride{{StoreKey: "unknown", Key: anteKey, Delete: true}},
+	}, false)
+	require.ErrorContains(t, err, "unknown store")
+
+	// the account overrides require a StateOverrider
+	addr := sdk.AccAddress("addr")
+	accountOverrides := txtypes.StateOverrides{
+		Balances:  []txtypes.BalanceOverride{{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+		Sequences: []txtypes.SequenceOverride{{Address: addr.String(), Sequence: 3}},
+	}
+	_, err = suite.baseApp.SimulateBundle(txs, accountOverrides, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	overrider := &stateOverrider{balances: map[string]sdk.Coins{}, sequences: map[string]uint64{}}
+	suite = NewBaseAppSuite(t, anteOpt, func(bapp *baseapp.BaseApp) { bapp.SetStateOverrider(overrider) })
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	suite.baseApp.Commit()
+
+	_, err = suite.baseApp.SimulateBundle(nil, accountOverrides, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Coins{addr.String(): accountOverrides.Balances[0].Coins}, overrider.balances)
+	require.Equal(t, map[string]uint64{addr.String(): 3}, overrider.sequences)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1385,6 +1578,165 @@ func TestABCI_Proposal_HappyPath(t *testing.T) {
 	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
 }
 
+func TestABCI_Proposal_MaxGas(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 1000},
+		},
+	})
+
+	var txs [][]byte
+	for nonce, gas := range []uint64{400, 700, 300, 400, 300} {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: 1}))
+		builder.SetGasLimit(gas)
+		setTxSignature(t, builder, uint64(nonce))
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+
+		bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+
+	// the transactions with 700 and 400 gas are skipped once 400 then 700 gas
+	// are used, the next ones with 300 gas still fit.
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 10000, Height: 1})
+	require.Equal(t, [][]byte{txs[0], txs[2], txs[4]}, res.Txs)
+
+	resProcess := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: res.Txs, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcess.Status)
+
+	resProcess = suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: txs[:2], Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcess.Status)
+}
+
+func TestABCI_Proposal_Lanes(t *testing.T) {
+	pool, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "counter2",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			Match:         mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	laneOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewLaneProposalHandler(pool, bapp)
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+		bapp.SetProcessProposal(handler.ProcessProposalHandler())
+	}
+
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), laneOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), Counter2ServerImpl{t, capKey1, []byte("deliver-key2")})
+
+	newTx := func(msg sdk.Msg, nonce, gas uint64) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetGasLimit(gas)
+		setTxSignature(t, builder, nonce)
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+
+		bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	// the counter transactions are inserted first but proposed last.
+	var counterTxs, counter2Txs [][]byte
+	for i := uint64(0); i < 3; i++ {
+		counterTxs = append(counterTxs, newTx(&baseapptestutil.MsgCounter{Counter: 1}, i, 300))
+	}
+	for i := uint64(0); i < 3; i++ {
+		counter2Txs = append(counter2Txs, newTx(&baseapptestutil.MsgCounter2{Counter: 1}, 3+i, 200))
+	}
+	txSize := int64(len(counter2Txs[0]))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 100 * txSize, MaxGas: 1000},
+		},
+	})
+
+	type processCase struct {
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}
+	processProposals := func(testCases map[string]processCase) {
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				res := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: tc.txs, Height: 1})
+				require.Equal(t, tc.status, res.Status)
+			})
+		}
+	}
+
+	// the counter2 lane may use 500 gas, i.e. 2 transactions, and the block
+	// 1000 gas, i.e. 2 more counter transactions.
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 100 * txSize, Height: 1})
+	require.Equal(t, append(counter2Txs[:2:2], counterTxs[:2]...), res.Txs)
+
+	processProposals(map[string]processCase{
+		"lanes in order": {
+			txs:    res.Txs,
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"empty lane": {
+			txs:    counterTxs,
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    [][]byte{counterTxs[0], counter2Txs[0]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"lane over its gas limit": {
+			txs:    counter2Txs,
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"block over its gas limit": {
+			txs:    append(counter2Txs[:2:2], counterTxs...),
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"undecodable tx": {
+			txs:    [][]byte{counter2Txs[0], []byte("tx")},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	})
+
+	// the counter2 lane may use 1.5 transactions worth of the block bytes.
+	suite.baseApp.StoreConsensusParams(sdk.Context{}, &cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 3 * txSize, MaxGas: 1000},
+	})
+	res = suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 100 * txSize, Height: 1})
+	require.Equal(t, append(counter2Txs[:1:1], counterTxs[:2]...), res.Txs)
+
+	// MaxTxBytes bounds the whole block.
+	res = suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 2 * txSize, Height: 1})
+	require.Equal(t, append(counter2Txs[:1:1], counterTxs[0]), res.Txs)
+
+	processProposals(map[string]processCase{
+		"lanes within their bytes": {
+			txs:    append(counter2Txs[:1:1], counterTxs[:2]...),
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lane over its bytes limit": {
+			txs:    counter2Txs[:2],
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	})
+}
+
 func TestABCI_Proposal_Read_State_PrepareProposal(t *testing.T) {
 	someKey := []byte("some-key")
 
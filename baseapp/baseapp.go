@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -37,14 +38,41 @@ type (
 )
 
 const (
-	runTxModeCheck       runTxMode = iota // Check a transaction
-	runTxModeReCheck                      // Recheck a (pending) transaction after a commit
-	runTxModeSimulate                     // Simulate a transaction
-	runTxModeDeliver                      // Deliver a transaction
-	runTxPrepareProposal                  // Prepare a TM block proposal
-	runTxProcessProposal                  // Process a TM block proposal
+	runTxModeCheck          runTxMode = iota // Check a transaction
+	runTxModeReCheck                         // Recheck a (pending) transaction after a commit
+	runTxModeSimulate                        // Simulate a transaction
+	runTxModeDeliver                         // Deliver a transaction
+	runTxPrepareProposal                     // Prepare a TM block proposal
+	runTxProcessProposal                     // Process a TM block proposal
+	runTxModeSimulateSigned                  // Simulate a transaction on a branch, verifying its signatures
+	runTxModeSimulateBranch                  // Simulate a transaction on a branch
+	runTxModeTrace                           // Deliver a transaction on a branch, leaving the mempool untouched
 )
 
+// isDeliver returns true if the mode delivers a transaction, whether in a block
+// or traced on a branch.
+func (m runTxMode) isDeliver() bool {
+	return m == runTxModeDeliver || m == runTxModeTrace
+}
+
+// isSimulate returns true if the mode simulates a transaction, whether or not
+// its signatures are verified.
+func (m runTxMode) isSimulate() bool {
+	return m == runTxModeSimulate || m == runTxModeSimulateSigned || m == runTxModeSimulateBranch
+}
+
+// skipsSignatures returns true if the mode simulates a transaction without
+// verifying its signatures.
+func (m runTxMode) skipsSignatures() bool {
+	return m == runTxModeSimulate || m == runTxModeSimulateBranch
+}
+
+// writesBranch returns true if the mode simulates a transaction on a
+// throwaway branch, to which the state changes of its messages are written.
+func (m runTxMode) writesBranch() bool {
+	return m == runTxModeSimulateSigned || m == runTxModeSimulateBranch
+}
+
 var _ abci.Application = (*BaseApp)(nil)
 
 // BaseApp reflects the ABCI application implementation.
@@ -96,6 +124,19 @@ type BaseApp struct { //nolint: maligned
 	// application parameter store.
 	paramStore ParamStore
 
+	// gasScheduleStore is used to query for the gas schedule of the KVStores
+	// from an application parameter store.
+	gasScheduleStore GasScheduleStore
+
+	// stateOverrider is used to apply the account overrides of the bundle
+	// simulations.
+	stateOverrider StateOverrider
+
+	// defaultQueryLimits are the limits of the gRPC query methods without
+	// their own limits in queryMethodLimits.
+	defaultQueryLimits QueryLimits
+	queryMethodLimits  map[string]QueryLimits
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -362,6 +403,9 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	// CheckTx runs with the committed gas schedule until the next BeginBlock.
+	app.checkState.ctx = app.checkState.ctx.WithKVGasSchedule(app.GetGasSchedule(app.checkState.ctx))
+
 	return app.cms.GetPruning().Validate()
 }
 
@@ -381,6 +425,40 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+// Close stops the background work of the BaseApp, such as the asynchronous
+// pruning of the CommitMultiStore. It must be called once the node stopped
+// committing blocks.
+func (app *BaseApp) Close() error {
+	if cms, ok := app.cms.(interface{ StopAsyncPruning() }); ok {
+		cms.StopAsyncPruning()
+	}
+
+	return nil
+}
+
+func (app *BaseApp) setAsyncPruning(heightsPerSecond uint64) {
+	cms, ok := app.cms.(interface{ SetAsyncPruning(uint64) })
+	if !ok {
+		panic(fmt.Errorf("async pruning is not supported by %T", app.cms))
+	}
+
+	cms.SetAsyncPruning(heightsPerSecond)
+}
+
+func (app *BaseApp) setStateStorage(db dbm.DB) {
+	cms, ok := app.cms.(interface{ SetStateStorage(dbm.DB) })
+	if !ok {
+		panic(fmt.Errorf("state storage is not supported by %T", app.cms))
+	}
+
+	cms.SetStateStorage(db)
+}
+
+func (app *BaseApp) setQueryLimits(limits QueryLimits, methodLimits map[string]QueryLimits) {
+	app.defaultQueryLimits = limits
+	app.queryMethodLimits = methodLimits
+}
+
 func (app *BaseApp) setInterBlockCache(cache storetypes.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -447,6 +525,22 @@ func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *cmtproto.ConsensusParam
 	return cp
 }
 
+// GetGasSchedule returns the gas schedule of the KVStores from the BaseApp's
+// GasScheduleStore. If the BaseApp has no GasScheduleStore defined, the default
+// gas schedule is returned.
+func (app *BaseApp) GetGasSchedule(ctx sdk.Context) sdk.GasSchedule {
+	if app.gasScheduleStore == nil {
+		return sdk.DefaultGasSchedule()
+	}
+
+	schedule, err := app.gasScheduleStore.GetGasSchedule(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return schedule
+}
+
 // StoreConsensusParams sets the consensus parameters to the baseapp's param store.
 func (app *BaseApp) StoreConsensusParams(ctx sdk.Context, cp *cmtproto.ConsensusParams) {
 	if app.paramStore == nil {
@@ -612,22 +706,27 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is runTx executed with the given context instead of the
+// context of the state of the mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
-	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
+	if mode.isDeliver() && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, 0, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, newQueryTimeoutRecoveryMiddleware(app.runTxRecoveryMiddleware))
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
@@ -654,7 +753,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// NOTE: consumeBlockGas must exist in a separate defer function from the
 	// general deferred recovery function to recover from consumeBlockGas as it'll
 	// be executed first (deferred statements are executed as stack).
-	if mode == runTxModeDeliver {
+	if mode.isDeliver() {
 		defer consumeBlockGas()
 	}
 
@@ -668,6 +767,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		return sdk.GasInfo{}, nil, nil, 0, err
 	}
 
+	// anteCache is the state of the AnteHandler, written once the tx passed it
+	// and, in CheckTx, was inserted in the mempool.
+	var anteCache storetypes.CacheMultiStore
+
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
@@ -683,7 +786,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
-		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
+		newCtx, err := app.anteHandler(anteCtx, tx, mode.skipsSignatures())
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -705,16 +808,33 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		priority = ctx.Priority()
-		msCache.Write()
+		anteCache = msCache
 		anteEvents = events.ToABCIEvents()
 	}
 
+	// the txs run by a query are bounded by its limits
+	ctx = withQueryTxGasMeter(ctx)
+
 	if mode == runTxModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		// The tx is inserted in the mempool before the state of the AnteHandler
+		// is written, so that a tx rejected by the mempool leaves no state
+		// changes, e.g. to the sequences or the rate limits of its signers.
+		insertCtx := ctx
+		if anteCache != nil {
+			insertCtx = ctx.WithMultiStore(anteCache)
+		}
+
+		err = app.mempool.Insert(insertCtx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, priority, err
 		}
-	} else if mode == runTxModeDeliver {
+	}
+
+	if anteCache != nil {
+		anteCache.Write()
+	}
+
+	if mode == runTxModeDeliver {
 		err = app.mempool.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
@@ -741,7 +861,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// Note that the state is still preserved.
 			postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
-			newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate, err == nil)
+			newCtx, err := app.postHandler(postCtx, tx, mode.skipsSignatures(), err == nil)
 			if err != nil {
 				return gInfo, nil, anteEvents, priority, err
 			}
@@ -749,14 +869,21 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
 
-		if mode == runTxModeDeliver {
+		if mode.isDeliver() {
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
 			msCache.Write()
 		}
 
-		if len(anteEvents) > 0 && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
+		if mode.writesBranch() {
+			// The simulation context is a throwaway branch, writing to it lets
+			// SimulateOnBranch callers and the next txs of SimulateBundle observe
+			// the state changes of the tx.
+			msCache.Write()
+		}
+
+		if len(anteEvents) > 0 && (mode.isDeliver() || mode.isSimulate()) {
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
 		}
@@ -777,7 +904,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
-		if mode != runTxModeDeliver && mode != runTxModeSimulate {
+		if !mode.isDeliver() && !mode.isSimulate() {
 			break
 		}
 
@@ -924,7 +1051,9 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// reached or the mempool is exhausted. The gas limits declared by the selected
+// transactions add up to at most the consensus MaxGas of the block, the
+// transactions which do not fit in the gas left being skipped.
 //
 // Note:
 //
@@ -948,8 +1077,10 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
+			totalTxGas   int64
 		)
 
+		maxBlockGas := ctx.ConsensusParams().GetBlock().GetMaxGas()
 		iterator := h.mempool.Select(ctx, req.Txs)
 
 		for iterator != nil {
@@ -967,13 +1098,20 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 				}
 			} else {
 				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
+				if totalTxBytes += txSize; totalTxBytes > req.MaxTxBytes {
 					// We've reached capacity per req.MaxTxBytes so we cannot select any
 					// more transactions.
 					break
 				}
+
+				// A transaction whose gas limit exceeds the gas left in the block is
+				// skipped, as a later transaction with a smaller gas limit may fit.
+				if txGas := txGas(memTx); maxBlockGas > 0 && txGas > maxBlockGas-totalTxGas {
+					totalTxBytes -= txSize
+				} else {
+					totalTxGas += txGas
+					selectedTxs = append(selectedTxs, bz)
+				}
 			}
 
 			iterator = iterator.Next()
@@ -989,24 +1127,49 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 // 1. The transaction bytes must decode to a valid transaction.
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
-// If any transaction fails to pass either condition, the proposal is rejected.
+// If any transaction fails to pass either condition, or if the gas limits declared
+// by the transactions add up to more than the consensus MaxGas of the block, the
+// proposal is rejected.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
 func (h DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		var totalTxGas int64
+
+		maxBlockGas := ctx.ConsensusParams().GetBlock().GetMaxGas()
 		for _, txBytes := range req.Txs {
-			_, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
 			}
+
+			txGas := txGas(tx)
+			if maxBlockGas > 0 && txGas > maxBlockGas-totalTxGas {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			totalTxGas += txGas
 		}
 
 		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
 	}
 }
 
+// txGas returns the gas limit declared by tx, zero if it is not a FeeTx.
+func txGas(tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+
+	if gas := feeTx.GetGas(); gas <= math.MaxInt64 {
+		return int64(gas)
+	}
+
+	return math.MaxInt64
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -600,6 +601,100 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	require.Panics(t, func() { suite.baseApp.GetMaximumBlockGas(ctx) })
 }
 
+func TestAsyncPruning(t *testing.T) {
+	pruningOpt := baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10))
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, pruningOpt, baseapp.SetAsyncPruning(0))
+
+	capKey := storetypes.NewKVStoreKey("key1")
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion())
+
+	for i := int64(1); i <= 20; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: i}})
+		app.Commit()
+	}
+
+	// the heights are pruned in the background
+	require.Eventually(t, func() bool {
+		_, err := app.CommitMultiStore().CacheMultiStoreWithVersion(17)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, app.Close())
+	_, err := app.CommitMultiStore().CacheMultiStoreWithVersion(18)
+	require.NoError(t, err)
+}
+
+func TestStateStorage(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	capKey := storetypes.NewKVStoreKey("key1")
+	newApp := func() *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil, baseapp.SetStateStorage(ssDB))
+		app.MountStores(capKey)
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+
+	app := newApp()
+	app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	app.Commit()
+
+	app.CommitMultiStore().GetKVStore(capKey).Set([]byte("key"), []byte("value"))
+	app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 2}})
+	app.Commit()
+
+	// the state is served by the state storage, which records every version
+	app = newApp()
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), cms.GetKVStore(capKey).Get([]byte("key")))
+
+	latest, err := versiondb.NewStore(ssDB).LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest)
+}
+
+type countingGasScheduleStore struct {
+	reads int
+}
+
+func (s *countingGasScheduleStore) GetGasSchedule(sdk.Context) (sdk.GasSchedule, error) {
+	s.reads++
+	return sdk.DefaultGasSchedule(), nil
+}
+
+func TestGasScheduleLoadedPerBlock(t *testing.T) {
+	txDecoder := func([]byte) (sdk.Tx, error) { return nil, fmt.Errorf("invalid tx") }
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txDecoder)
+
+	gasScheduleStore := &countingGasScheduleStore{}
+	app.SetGasScheduleStore(gasScheduleStore)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+	require.Equal(t, 1, gasScheduleStore.reads)
+
+	// the txs inherit the gas schedule loaded by BeginBlock
+	for i := int64(1); i <= 3; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: i}})
+		app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		app.Commit()
+		app.CheckTx(abci.RequestCheckTx{Tx: []byte("tx")})
+	}
+	require.Equal(t, 4, gasScheduleStore.reads)
+
+	// the query and proposal contexts load the gas schedule on their own
+	_, err := app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, 5, gasScheduleStore.reads)
+
+	app.PrepareProposal(abci.RequestPrepareProposal{Height: 4, MaxTxBytes: 1000})
+	require.Equal(t, 6, gasScheduleStore.reads)
+
+	app.ProcessProposal(abci.RequestProcessProposal{Height: 4})
+	require.Equal(t, 7, gasScheduleStore.reads)
+}
+
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOptions := pruningtypes.NewCustomPruningOptions(10, 15)
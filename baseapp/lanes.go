@@ -0,0 +1,167 @@
+package baseapp
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneProposalHandler defines ABCI PrepareProposal and ProcessProposal handlers
+// dividing the block space into the lanes of a mempool.LaneMempool. The
+// transactions of a proposal are grouped by lane, in the order of the lanes, and
+// the transactions of each lane use at most its share of the bytes and of the
+// gas of the block.
+type LaneProposalHandler struct {
+	mempool    *mempool.LaneMempool
+	txVerifier ProposalTxVerifier
+}
+
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) LaneProposalHandler {
+	return LaneProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// laneLimits are the bytes and gas a lane may use in a block, which only apply
+// if the block has a maximum bytes or gas.
+type laneLimits struct {
+	maxBytes int64
+	maxGas   int64
+}
+
+func (h LaneProposalHandler) laneLimits(maxBytes, maxGas int64) []laneLimits {
+	lanes := h.mempool.Lanes()
+	limits := make([]laneLimits, len(lanes))
+	for i, lane := range lanes {
+		limits[i] = laneLimits{
+			maxBytes: laneShare(lane.MaxBlockSpace, maxBytes),
+			maxGas:   laneShare(lane.MaxBlockSpace, maxGas),
+		}
+	}
+
+	return limits
+}
+
+func laneShare(share math.LegacyDec, limit int64) int64 {
+	if limit <= 0 {
+		return limit
+	}
+
+	return share.MulInt64(limit).TruncateInt64()
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler filling the block
+// lane by lane. The transactions of a lane are selected from its mempool, in
+// its order, and verified like in DefaultProposalHandler, until the share of
+// the maximum bytes or gas of the block of the lane, or
+// RequestPrepareProposal.MaxTxBytes, is reached. The space left unused by a
+// lane is not given to the next lanes.
+//
+// The shares are computed from the consensus params, as ProcessProposal is not
+// given RequestPrepareProposal.MaxTxBytes, so that both apply the same limits.
+func (h LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var (
+			selectedTxs  [][]byte
+			totalTxBytes int64
+			totalTxGas   int64
+		)
+
+		block := ctx.ConsensusParams().GetBlock()
+		maxBytes, maxGas := block.GetMaxBytes(), block.GetMaxGas()
+		limits := h.laneLimits(maxBytes, maxGas)
+
+		for i, lane := range h.mempool.Lanes() {
+			var laneTxBytes, laneTxGas int64
+
+			for iterator := lane.Mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+				memTx := iterator.Tx()
+
+				// A transaction matching a previous lane would break the lane order.
+				if h.mempool.LaneIndex(memTx) != i {
+					continue
+				}
+
+				bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					err := lane.Mempool.Remove(memTx)
+					if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						panic(err)
+					}
+					continue
+				}
+
+				txSize, txGas := int64(len(bz)), txGas(memTx)
+				if totalTxBytes+txSize > req.MaxTxBytes || (maxBytes > 0 && txSize > limits[i].maxBytes-laneTxBytes) {
+					break
+				}
+				// As in DefaultProposalHandler, a transaction over the gas left is
+				// skipped for the transactions with a smaller gas limit.
+				if maxGas > 0 && (txGas > limits[i].maxGas-laneTxGas || txGas > maxGas-totalTxGas) {
+					continue
+				}
+
+				laneTxBytes += txSize
+				laneTxGas += txGas
+				totalTxBytes += txSize
+				totalTxGas += txGas
+				selectedTxs = append(selectedTxs, bz)
+			}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: selectedTxs}
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler rejecting the
+// proposals with a transaction failing verification like in
+// DefaultProposalHandler, with transactions out of the lane order, or with a
+// lane using more than its share of the maximum bytes or gas of the block, or
+// with more gas than the maximum gas of the block.
+func (h LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		block := ctx.ConsensusParams().GetBlock()
+		maxBytes, maxGas := block.GetMaxBytes(), block.GetMaxGas()
+		limits := h.laneLimits(maxBytes, maxGas)
+
+		var (
+			lane       int
+			laneBytes  int64
+			laneGas    int64
+			totalTxGas int64
+		)
+
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+
+			i := h.mempool.LaneIndex(tx)
+			if i < lane {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			if i > lane {
+				lane, laneBytes, laneGas = i, 0, 0
+			}
+
+			txSize, txGas := int64(len(txBytes)), txGas(tx)
+			if maxBytes > 0 && txSize > limits[lane].maxBytes-laneBytes {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			if maxGas > 0 && (txGas > limits[lane].maxGas-laneGas || txGas > maxGas-totalTxGas) {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			laneBytes += txSize
+			laneGas += txGas
+			totalTxGas += txGas
+		}
+
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+}
@@ -26,6 +26,7 @@ type MessageRouter interface {
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	interceptors      []MsgInterceptor
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -40,6 +41,57 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// MsgHandler executes a Msg and returns its response.
+type MsgHandler = func(ctx sdk.Context, msg sdk.Msg) (proto.Message, error)
+
+// MsgInterceptor intercepts the execution of a Msg by its handler: it is
+// called with the Msg and the next interceptor, or the handler, and returns the
+// response and error of the execution. It may inspect the Msg, reject it by
+// returning an error without calling next, emit events, and inspect or replace
+// the response and error returned by next.
+//
+// The Context has the event manager of the Msg, so the events emitted by an
+// interceptor, before or after calling next, are part of the Msg result. The
+// Context passed to next must derive from it.
+type MsgInterceptor = func(ctx sdk.Context, msg sdk.Msg, next MsgHandler) (proto.Message, error)
+
+// AddInterceptors adds interceptors called around the execution of every Msg
+// routed by msr, after its ValidateBasic. The interceptors are called in the
+// order they are added, the first one being the outermost. Since x/authz
+// MsgExec, x/gov and x/group proposals execute their messages through the
+// router too, the interceptors are called for these nested messages as well.
+func (msr *MsgServiceRouter) AddInterceptors(interceptors ...MsgInterceptor) {
+	msr.interceptors = append(msr.interceptors, interceptors...)
+}
+
+type msgInterceptorKey struct{}
+
+// contextWithMsgInterceptor returns a copy of ctx whose Msgs are intercepted by
+// interceptor, outside of the interceptors of the router. Unlike the
+// interceptors added to the router, it only applies to the executions with ctx,
+// e.g. to trace a tx while the app processes blocks.
+func contextWithMsgInterceptor(ctx sdk.Context, interceptor MsgInterceptor) sdk.Context {
+	return ctx.WithValue(msgInterceptorKey{}, interceptor)
+}
+
+// intercept calls the interceptors of msr, and the one of ctx, around handler.
+func (msr *MsgServiceRouter) intercept(ctx sdk.Context, msg sdk.Msg, handler MsgHandler) (proto.Message, error) {
+	for i := len(msr.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := msr.interceptors[i], handler
+		handler = func(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+			return interceptor(ctx, msg, next)
+		}
+	}
+
+	if ctx.Context() != nil {
+		if interceptor, ok := ctx.Value(msgInterceptorKey{}).(MsgInterceptor); ok {
+			return interceptor(ctx, msg, handler)
+		}
+	}
+
+	return handler(ctx, msg)
+}
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -115,16 +167,12 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
+		msgHandler := func(ctx sdk.Context, req sdk.Msg) (proto.Message, error) {
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 				return handler(goCtx, req)
 			}
 
-			if err := req.ValidateBasic(); err != nil {
-				return nil, err
-			}
 			// Call the method handler from the service description with the handler object.
 			// We don't do any decoding here because the decoding was already done.
 			res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
@@ -137,6 +185,21 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
 			}
 
+			return resMsg, nil
+		}
+
+		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			if err := req.ValidateBasic(); err != nil {
+				return nil, err
+			}
+
+			resMsg, err := msr.intercept(ctx, req, msgHandler)
+			if err != nil {
+				return nil, err
+			}
+
 			return sdk.WrapServiceResult(ctx, resMsg, err)
 		}
 	}
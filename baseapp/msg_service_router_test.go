@@ -1,23 +1,47 @@
 package baseapp_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/group/module"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
 
 func TestRegisterMsgService(t *testing.T) {
@@ -77,6 +101,218 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 	})
 }
 
+func TestMsgServiceInterceptors(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(log.NewTestLogger(t), dbm.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	var calls []string
+	app.MsgServiceRouter().AddInterceptors(
+		func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgHandler) (proto.Message, error) {
+			calls = append(calls, "audit")
+			res, err := next(ctx, msg)
+			ctx.EventManager().EmitEvent(sdk.NewEvent("audit", sdk.NewAttribute("error", fmt.Sprint(err))))
+			return res, err
+		},
+		func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgHandler) (proto.Message, error) {
+			calls = append(calls, "reject")
+			if msg.(*testdata.MsgCreateDog).Dog.Name == "Rex" {
+				return nil, errors.New("no Rex")
+			}
+			return next(ctx, msg)
+		},
+	)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"audit", "reject"}, calls)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "audit", res.Events[0].Type)
+	require.Equal(t, "<nil>", string(res.Events[0].Attributes[0].Value))
+	require.Len(t, res.MsgResponses, 1)
+	require.Equal(t, "/"+proto.MessageName(&testdata.MsgCreateDogResponse{}), res.MsgResponses[0].TypeUrl)
+
+	calls = nil
+	_, err = handler(ctx, &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}})
+	require.EqualError(t, err, "no Rex")
+	require.Equal(t, []string{"audit", "reject"}, calls)
+}
+
+// interceptorFixture is an app with x/authz, x/gov and x/group, whose router
+// records the bank MsgSends and rejects the ones sending rejectedCoins.
+type interceptorFixture struct {
+	app           *runtime.App
+	ctx           sdk.Context
+	addrs         []sdk.AccAddress
+	voter         sdk.AccAddress
+	bankKeeper    bankkeeper.Keeper
+	authzKeeper   authzkeeper.Keeper
+	govKeeper     *govkeeper.Keeper
+	groupKeeper   groupkeeper.Keeper
+	interceptions int
+}
+
+var (
+	sentCoins     = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	rejectedCoins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 13))
+)
+
+func newInterceptorFixture(t *testing.T) *interceptorFixture {
+	t.Helper()
+
+	f := &interceptorFixture{}
+	var stakingKeeper *stakingkeeper.Keeper
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	app, err := simtestutil.SetupWithConfiguration(
+		configurator.NewAppConfig(
+			configurator.ParamsModule(),
+			configurator.AuthModule(),
+			configurator.StakingModule(),
+			configurator.BankModule(),
+			configurator.GovModule(),
+			configurator.ConsensusModule(),
+			configurator.DistributionModule(),
+			configurator.AuthzModule(),
+			configurator.GroupModule(),
+		),
+		startupCfg,
+		&f.bankKeeper, &stakingKeeper, &f.authzKeeper, &f.govKeeper, &f.groupKeeper,
+	)
+	require.NoError(t, err)
+
+	f.app = app
+	f.ctx = app.BaseApp.NewContext(false, cmtproto.Header{})
+	f.addrs = simtestutil.AddTestAddrs(f.bankKeeper, stakingKeeper, f.ctx, 2, sdkmath.NewInt(1000000000))
+	// the genesis account holds the delegation of the validator
+	f.voter = startupCfg.GenesisAccounts[0].GetAddress()
+
+	app.MsgServiceRouter().AddInterceptors(func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgHandler) (proto.Message, error) {
+		if send, ok := msg.(*banktypes.MsgSend); ok {
+			f.interceptions++
+			if send.Amount.Equal(rejectedCoins) {
+				return nil, errors.New("rejected send")
+			}
+		}
+		return next(ctx, msg)
+	})
+
+	return f
+}
+
+func TestMsgServiceInterceptorsAuthzExec(t *testing.T) {
+	for _, amount := range []sdk.Coins{sentCoins, rejectedCoins} {
+		f := newInterceptorFixture(t)
+		granter, grantee := f.addrs[0], f.addrs[1]
+		expiration := f.ctx.BlockTime().Add(time.Hour)
+		err := f.authzKeeper.SaveGrant(f.ctx, grantee, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), &expiration)
+		require.NoError(t, err)
+
+		balance := f.bankKeeper.GetAllBalances(f.ctx, granter)
+		msg := authz.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, amount)})
+		_, err = f.app.MsgServiceRouter().Handler(&msg)(f.ctx, &msg)
+		require.Equal(t, 1, f.interceptions)
+
+		if amount.Equal(rejectedCoins) {
+			require.ErrorContains(t, err, "rejected send")
+			require.Equal(t, balance, f.bankKeeper.GetAllBalances(f.ctx, granter))
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, balance.Sub(amount...), f.bankKeeper.GetAllBalances(f.ctx, granter))
+		}
+	}
+}
+
+func TestMsgServiceInterceptorsGovProposal(t *testing.T) {
+	for _, amount := range []sdk.Coins{sentCoins, rejectedCoins} {
+		f := newInterceptorFixture(t)
+		govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+		// fund the gov account apart from the deposits, refunded before the
+		// messages are executed
+		err := f.bankKeeper.SendCoinsFromAccountToModule(f.ctx, f.addrs[0], govtypes.ModuleName, amount)
+		require.NoError(t, err)
+
+		proposal, err := f.govKeeper.SubmitProposal(f.ctx, []sdk.Msg{banktypes.NewMsgSend(govAddr, f.addrs[1], amount)}, "", "title", "summary", f.addrs[0], false)
+		require.NoError(t, err)
+		params := f.govKeeper.GetParams(f.ctx)
+		_, err = f.govKeeper.AddDeposit(f.ctx, proposal.Id, f.addrs[0], params.MinDeposit)
+		require.NoError(t, err)
+		err = f.govKeeper.AddVote(f.ctx, proposal.Id, f.voter, govv1.NewNonSplitVoteOption(govv1.OptionYes), "")
+		require.NoError(t, err)
+
+		balance := f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1])
+		header := f.ctx.BlockHeader()
+		header.Time = header.Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+		ctx := f.ctx.WithBlockHeader(header)
+		gov.EndBlocker(ctx, f.govKeeper)
+		require.Equal(t, 1, f.interceptions)
+
+		proposal, ok := f.govKeeper.GetProposal(ctx, proposal.Id)
+		require.True(t, ok)
+		if amount.Equal(rejectedCoins) {
+			require.Equal(t, govv1.StatusFailed, proposal.Status)
+			require.Equal(t, balance, f.bankKeeper.GetAllBalances(ctx, f.addrs[1]))
+		} else {
+			require.Equal(t, govv1.StatusPassed, proposal.Status)
+			require.Equal(t, balance.Add(amount...), f.bankKeeper.GetAllBalances(ctx, f.addrs[1]))
+		}
+	}
+}
+
+func TestMsgServiceInterceptorsGroupProposal(t *testing.T) {
+	for _, amount := range []sdk.Coins{sentCoins, rejectedCoins} {
+		f := newInterceptorFixture(t)
+		admin := f.addrs[0].String()
+		createMsg, err := group.NewMsgCreateGroupWithPolicy(
+			admin,
+			[]group.MemberRequest{{Address: admin, Weight: "1"}},
+			"", "", false,
+			group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+		)
+		require.NoError(t, err)
+		createRes, err := f.groupKeeper.CreateGroupWithPolicy(f.ctx, createMsg)
+		require.NoError(t, err)
+		policyAddr, err := sdk.AccAddressFromBech32(createRes.GroupPolicyAddress)
+		require.NoError(t, err)
+		require.NoError(t, f.bankKeeper.SendCoins(f.ctx, f.addrs[0], policyAddr, amount))
+
+		submitMsg, err := group.NewMsgSubmitProposal(
+			createRes.GroupPolicyAddress,
+			[]string{admin},
+			[]sdk.Msg{banktypes.NewMsgSend(policyAddr, f.addrs[1], amount)},
+			"", group.Exec_EXEC_UNSPECIFIED, "title", "summary",
+		)
+		require.NoError(t, err)
+		submitRes, err := f.groupKeeper.SubmitProposal(f.ctx, submitMsg)
+		require.NoError(t, err)
+		_, err = f.groupKeeper.Vote(f.ctx, &group.MsgVote{ProposalId: submitRes.ProposalId, Voter: admin, Option: group.VOTE_OPTION_YES})
+		require.NoError(t, err)
+
+		balance := f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1])
+		execRes, err := f.groupKeeper.Exec(f.ctx, &group.MsgExec{ProposalId: submitRes.ProposalId, Executor: admin})
+		require.NoError(t, err)
+		require.Equal(t, 1, f.interceptions)
+
+		if amount.Equal(rejectedCoins) {
+			require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, execRes.Result)
+			require.Equal(t, balance, f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1]))
+		} else {
+			require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+			require.Equal(t, balance.Add(amount...), f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1]))
+		}
+	}
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 
@@ -76,6 +76,27 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetAsyncPruning provides a BaseApp option function that makes the
+// CommitMultiStore prune its heights on a background worker, at most
+// heightsPerSecond heights per second, 0 meaning no limit.
+func SetAsyncPruning(heightsPerSecond uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setAsyncPruning(heightsPerSecond) }
+}
+
+// SetStateStorage provides a BaseApp option function that separates the state
+// storage of the CommitMultiStore from its state commitment, the state being
+// recorded in db.
+func SetStateStorage(db dbm.DB) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setStateStorage(db) }
+}
+
+// SetQueryLimits returns a BaseApp option function that sets the gas limit and
+// the timeout of the gRPC queries, methodLimits overriding the limits of the
+// given methods.
+func SetQueryLimits(limits QueryLimits, methodLimits map[string]QueryLimits) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setQueryLimits(limits, methodLimits) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -114,6 +135,26 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetGasScheduleStore sets the store of the gas schedule of the KVStores on the
+// BaseApp.
+func (app *BaseApp) SetGasScheduleStore(gs GasScheduleStore) {
+	if app.sealed {
+		panic("SetGasScheduleStore() on sealed BaseApp")
+	}
+
+	app.gasScheduleStore = gs
+}
+
+// SetStateOverrider sets the StateOverrider applying the account overrides of
+// SimulateBundle on the BaseApp.
+func (app *BaseApp) SetStateOverrider(so StateOverrider) {
+	if app.sealed {
+		panic("SetStateOverrider() on sealed BaseApp")
+	}
+
+	app.stateOverrider = so
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
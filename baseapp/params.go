@@ -13,3 +13,9 @@ type ParamStore interface {
 	Has(ctx sdk.Context) bool
 	Set(ctx sdk.Context, cp *cmtproto.ConsensusParams)
 }
+
+// GasScheduleStore defines the interface the store of the gas schedule of the
+// KVStores used by the BaseApp must fulfill.
+type GasScheduleStore interface {
+	GetGasSchedule(ctx sdk.Context) (sdk.GasSchedule, error)
+}
@@ -0,0 +1,237 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// QueryLimits are the limits of the gRPC queries, served by the gRPC server or
+// through ABCI Query. A zero value means no limit.
+type QueryLimits struct {
+	// GasLimit is the gas a query may consume in the stores.
+	GasLimit storetypes.Gas
+	// Timeout is the wall-clock duration of a query. A query is aborted when it
+	// accesses the stores, or checks its context, past its timeout. A query
+	// spending its time elsewhere runs to completion, its result being
+	// discarded for an ErrQueryTimeout error.
+	Timeout time.Duration
+}
+
+// ParseQueryMethodLimits parses the limits of gRPC query methods, in the
+// format "<method>:<gas limit>:<timeout>", e.g.
+// "/cosmos.bank.v1beta1.Query/AllBalances:1000000:2s", a zero gas limit or
+// timeout meaning no limit.
+func ParseQueryMethodLimits(limits []string) (map[string]QueryLimits, error) {
+	methodLimits := make(map[string]QueryLimits, len(limits))
+	for _, limit := range limits {
+		parts := strings.Split(limit, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid query method limit %q, expected <method>:<gas limit>:<timeout>", limit)
+		}
+
+		gasLimit, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas limit of query method limit %q: %w", limit, err)
+		}
+
+		timeout, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout of query method limit %q: %w", limit, err)
+		}
+		if timeout < 0 {
+			return nil, fmt.Errorf("negative timeout of query method limit %q", limit)
+		}
+
+		if _, ok := methodLimits[parts[0]]; ok {
+			return nil, fmt.Errorf("duplicate query method limit for %s", parts[0])
+		}
+
+		methodLimits[parts[0]] = QueryLimits{GasLimit: gasLimit, Timeout: timeout}
+	}
+
+	return methodLimits, nil
+}
+
+// queryLimits returns the limits of the query method, its own limits if set,
+// the default limits otherwise.
+func (app *BaseApp) queryLimits(method string) QueryLimits {
+	if limits, ok := app.queryMethodLimits[method]; ok {
+		return limits
+	}
+
+	return app.defaultQueryLimits
+}
+
+// queryTimeout is the panic raised by a deadlineGasMeter past its deadline.
+type queryTimeout struct {
+	descriptor string
+}
+
+// deadlineGasMeter is the gas meter of a query with a timeout. It aborts the
+// query when gas is consumed, i.e. the stores are read, written or iterated,
+// once ctx is done, which is past the timeout of the query or the deadline of
+// the gRPC client if earlier.
+type deadlineGasMeter struct {
+	storetypes.GasMeter
+	ctx context.Context
+}
+
+func (m deadlineGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if errors.Is(m.ctx.Err(), context.DeadlineExceeded) {
+		panic(queryTimeout{descriptor})
+	}
+
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// withQueryLimits returns the gas meter enforcing limits, and a copy of ctx
+// with their timeout. cancel releases the resources of the timeout.
+func withQueryLimits(ctx sdk.Context, limits QueryLimits) (_ sdk.Context, gasMeter storetypes.GasMeter, cancel context.CancelFunc) {
+	gasMeter = storetypes.NewInfiniteGasMeter()
+	if limits.GasLimit > 0 {
+		gasMeter = storetypes.NewGasMeter(limits.GasLimit)
+	}
+
+	if limits.Timeout == 0 {
+		return ctx, gasMeter, func() {}
+	}
+
+	goCtx, cancel := context.WithTimeout(ctx.Context(), limits.Timeout)
+
+	return ctx.WithContext(goCtx), deadlineGasMeter{GasMeter: gasMeter, ctx: goCtx}, cancel
+}
+
+// runQuery runs query with ctx, within the limits of the query method. It
+// returns an ErrOutOfGas or an ErrQueryTimeout error when the query exceeds
+// them, and reports the gas consumed by the query.
+func (app *BaseApp) runQuery(ctx sdk.Context, method string, query func(ctx sdk.Context) error) (err error) {
+	limits := app.queryLimits(method)
+
+	ctx, gasMeter, cancel := withQueryLimits(ctx, limits)
+	defer cancel()
+
+	labels := []metrics.Label{telemetry.NewLabel("method", method)}
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "query %s exceeded its gas limit of %d in %s", method, limits.GasLimit, r.Descriptor)
+
+			case queryTimeout:
+				err = errorsmod.Wrapf(sdkerrors.ErrQueryTimeout, "query %s exceeded its timeout of %s in %s", method, limits.Timeout, r.descriptor)
+
+			default:
+				panic(r)
+			}
+		}
+
+		// the handlers watching the context of the query return its error, and
+		// the result of a query done past its deadline outside the stores is
+		// discarded
+		if errors.Is(ctx.Context().Err(), context.DeadlineExceeded) && !errors.Is(err, sdkerrors.ErrQueryTimeout) {
+			if err != nil {
+				err = errorsmod.Wrapf(sdkerrors.ErrQueryTimeout, "query %s exceeded its timeout of %s: %s", method, limits.Timeout, err)
+			} else if limits.Timeout > 0 {
+				err = errorsmod.Wrapf(sdkerrors.ErrQueryTimeout, "query %s exceeded its timeout of %s", method, limits.Timeout)
+			}
+		}
+
+		switch {
+		case errors.Is(err, sdkerrors.ErrOutOfGas):
+			telemetry.IncrCounterWithLabels([]string{"query", "gas", "exceeded"}, 1, labels)
+		case errors.Is(err, sdkerrors.ErrQueryTimeout):
+			telemetry.IncrCounterWithLabels([]string{"query", "timeout"}, 1, labels)
+		}
+
+		telemetry.AddSampleWithLabels([]string{"query", "gas", "used"}, float32(gasMeter.GasConsumed()), labels)
+	}()
+
+	return query(ctx.WithGasMeter(gasMeter))
+}
+
+type queryGasMeterKey struct{}
+
+// contextWithQueryGasMeter returns a copy of ctx whose txs also consume their
+// gas in gasMeter, the meter of the query running them. Unlike the gas meter of
+// ctx, it is not replaced by the AnteHandler, so that the txs are bounded by
+// the limits of the query.
+func contextWithQueryGasMeter(ctx sdk.Context, gasMeter storetypes.GasMeter) sdk.Context {
+	return ctx.WithValue(queryGasMeterKey{}, gasMeter)
+}
+
+// withQueryTxGasMeter returns a copy of the context of a tx whose gas is also
+// consumed in the meter of the query of ctx, if any. The gas consumed so far,
+// e.g. by the AnteHandler, is charged to the query first.
+func withQueryTxGasMeter(ctx sdk.Context) sdk.Context {
+	if ctx.Context() == nil {
+		return ctx
+	}
+
+	queryGasMeter, ok := ctx.Value(queryGasMeterKey{}).(storetypes.GasMeter)
+	if !ok {
+		return ctx
+	}
+
+	queryGasMeter.ConsumeGas(ctx.GasMeter().GasConsumed(), "tx before msgs")
+	return ctx.WithGasMeter(queryTxGasMeter{GasMeter: ctx.GasMeter(), query: queryGasMeter})
+}
+
+// queryTxGasMeter is the gas meter of a tx run by a query. The gas is consumed
+// in the meter of the tx, which reports it, and in the meter of the query.
+type queryTxGasMeter struct {
+	storetypes.GasMeter
+	query storetypes.GasMeter
+}
+
+func (m queryTxGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.query.ConsumeGas(amount, descriptor)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (m queryTxGasMeter) RefundGas(amount storetypes.Gas, descriptor string) {
+	m.query.RefundGas(amount, descriptor)
+	m.GasMeter.RefundGas(amount, descriptor)
+}
+
+// newQueryTimeoutRecoveryMiddleware creates the recovery middleware of the txs
+// run by a query past its timeout.
+func newQueryTimeoutRecoveryMiddleware(next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		r, ok := recoveryObj.(queryTimeout)
+		if !ok {
+			return nil
+		}
+
+		return errorsmod.Wrapf(sdkerrors.ErrQueryTimeout, "tx exceeded the timeout of the query in %s", r.descriptor)
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
+// queryLimitErrorToGRPC converts the errors of the queries exceeding their
+// limits to gRPC status errors, and returns the other errors unchanged.
+func queryLimitErrorToGRPC(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrOutOfGas):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, sdkerrors.ErrQueryTimeout):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return err
+	}
+}
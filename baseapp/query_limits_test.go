@@ -0,0 +1,250 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// scanQueryImpl is a query server whose Echo and TestAny read a store until
+// they are aborted by the limits of the query.
+type scanQueryImpl struct {
+	testdata.QueryImpl
+}
+
+func (scanQueryImpl) Echo(goCtx context.Context, _ *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	scan(goCtx)
+	return nil, nil
+}
+
+func (scanQueryImpl) TestAny(goCtx context.Context, _ *testdata.TestAnyRequest) (*testdata.TestAnyResponse, error) {
+	scan(goCtx)
+	return nil, nil
+}
+
+func scan(goCtx context.Context) {
+	store := sdk.UnwrapSDKContext(goCtx).KVStore(capKey1)
+	for {
+		store.Get([]byte("key"))
+	}
+}
+
+// sleepQueryImpl is a query server whose Echo sleeps without accessing the
+// stores.
+type sleepQueryImpl struct {
+	testdata.QueryImpl
+}
+
+func (sleepQueryImpl) Echo(_ context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	time.Sleep(20 * time.Millisecond)
+	return &testdata.EchoResponse{Message: req.Message}, nil
+}
+
+// grpcServer records the services registered by RegisterGRPCServer.
+type grpcServer struct {
+	services []*grpc.ServiceDesc
+}
+
+func (s *grpcServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.services = append(s.services, sd)
+}
+
+func TestParseQueryMethodLimits(t *testing.T) {
+	limits, err := baseapp.ParseQueryMethodLimits([]string{
+		"/cosmos.bank.v1beta1.Query/AllBalances:1000000:2s",
+		"/cosmos.staking.v1beta1.Query/Validators:0:500ms",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]baseapp.QueryLimits{
+		"/cosmos.bank.v1beta1.Query/AllBalances":   {GasLimit: 1_000_000, Timeout: 2 * time.Second},
+		"/cosmos.staking.v1beta1.Query/Validators": {GasLimit: 0, Timeout: 500 * time.Millisecond},
+	}, limits)
+
+	for _, limit := range []string{
+		"/cosmos.bank.v1beta1.Query/AllBalances:1000000",
+		":1000000:2s",
+		"/cosmos.bank.v1beta1.Query/AllBalances:-1:2s",
+		"/cosmos.bank.v1beta1.Query/AllBalances:1000000:2",
+		"/cosmos.bank.v1beta1.Query/AllBalances:1000000:-2s",
+	} {
+		_, err := baseapp.ParseQueryMethodLimits([]string{limit})
+		require.Error(t, err, limit)
+	}
+
+	_, err = baseapp.ParseQueryMethodLimits([]string{"/a.Query/B:1:1s", "/a.Query/B:2:1s"})
+	require.ErrorContains(t, err, "duplicate")
+}
+
+func TestABCI_QueryLimits(t *testing.T) {
+	newSuite := func(limits baseapp.QueryLimits, methodLimits map[string]baseapp.QueryLimits) *BaseAppSuite {
+		suite := NewBaseAppSuite(t, baseapp.SetQueryLimits(limits, methodLimits), func(bapp *baseapp.BaseApp) {
+			testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), scanQueryImpl{})
+		})
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+		suite.baseApp.Commit()
+
+		return suite
+	}
+
+	echo := func(suite *BaseAppSuite) abci.ResponseQuery {
+		reqBz, err := (&testdata.EchoRequest{Message: "foo"}).Marshal()
+		require.NoError(t, err)
+		return suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/Echo", Data: reqBz})
+	}
+
+	// the query is aborted past its gas limit, the other queries are served
+	suite := newSuite(baseapp.QueryLimits{GasLimit: 100_000}, nil)
+	res := echo(suite)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), res.Code, res.Log)
+	require.Contains(t, res.Log, "query /testpb.Query/Echo exceeded its gas limit of 100000")
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/SayHello", Data: reqBz})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+
+	// the limits of a method override the default ones
+	suite = newSuite(baseapp.QueryLimits{GasLimit: 100_000}, map[string]baseapp.QueryLimits{
+		"/testpb.Query/Echo": {Timeout: 10 * time.Millisecond},
+	})
+	res = echo(suite)
+	require.Equal(t, sdkerrors.ErrQueryTimeout.ABCICode(), res.Code, res.Log)
+	require.Contains(t, res.Log, "query /testpb.Query/Echo exceeded its timeout of 10ms")
+}
+
+func TestABCI_QueryLimitsOutsideStores(t *testing.T) {
+	suite := NewBaseAppSuite(t,
+		baseapp.SetQueryLimits(baseapp.QueryLimits{Timeout: 10 * time.Millisecond}, nil),
+		func(bapp *baseapp.BaseApp) {
+			testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), sleepQueryImpl{})
+		},
+	)
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.Commit()
+
+	// the query runs to completion, its result is discarded
+	reqBz, err := (&testdata.EchoRequest{Message: "foo"}).Marshal()
+	require.NoError(t, err)
+	res := suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/Echo", Data: reqBz})
+	require.Equal(t, sdkerrors.ErrQueryTimeout.ABCICode(), res.Code, res.Log)
+	require.Contains(t, res.Log, "query /testpb.Query/Echo exceeded its timeout of 10ms")
+	require.Empty(t, res.Value)
+}
+
+func TestGRPCServer_QueryLimits(t *testing.T) {
+	suite := NewBaseAppSuite(t,
+		baseapp.SetQueryLimits(baseapp.QueryLimits{GasLimit: 100_000}, map[string]baseapp.QueryLimits{
+			"/testpb.Query/Echo": {Timeout: 10 * time.Millisecond},
+		}),
+		func(bapp *baseapp.BaseApp) {
+			testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), scanQueryImpl{})
+		},
+	)
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.Commit()
+
+	server := &grpcServer{}
+	suite.baseApp.RegisterGRPCServer(server)
+
+	var methods map[string]grpc.MethodDesc
+	for _, sd := range server.services {
+		if sd.ServiceName == "testpb.Query" {
+			methods = make(map[string]grpc.MethodDesc)
+			for _, method := range sd.Methods {
+				methods[method.MethodName] = method
+			}
+		}
+	}
+	require.NotNil(t, methods)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	_, err := methods["Echo"].Handler(scanQueryImpl{}, ctx, func(interface{}) error { return nil }, nil)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err), err)
+
+	_, err = methods["TestAny"].Handler(scanQueryImpl{}, ctx, func(interface{}) error { return nil }, nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+
+	res, err := methods["SayHello"].Handler(scanQueryImpl{}, ctx, func(req interface{}) error {
+		req.(*testdata.SayHelloRequest).Name = "foo"
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Hello foo!", res.(*testdata.SayHelloResponse).Greeting)
+}
+
+func TestSimulateBundle_QueryLimits(t *testing.T) {
+	newSuite := func(limits baseapp.QueryLimits) *BaseAppSuite {
+		suite := NewBaseAppSuite(t,
+			baseapp.SetQueryLimits(baseapp.QueryLimits{}, map[string]baseapp.QueryLimits{
+				"/cosmos.tx.v1beta1.Service/SimulateBundle": limits,
+			}),
+			func(bapp *baseapp.BaseApp) {
+				bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key")))
+			},
+		)
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+		suite.baseApp.Commit()
+
+		return suite
+	}
+
+	simulate := func(suite *BaseAppSuite, n int64) []baseapp.BundleTxResult {
+		var txs [][]byte
+		for i := int64(0); i < n; i++ {
+			txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+		results, err := suite.baseApp.SimulateBundle(txs, txtypes.StateOverrides{}, false)
+		require.NoError(t, err)
+		require.Len(t, results, int(n))
+
+		return results
+	}
+
+	results := simulate(newSuite(baseapp.QueryLimits{}), 2)
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	minGasUsed, maxGasUsed := results[0].GasInfo.GasUsed, results[1].GasInfo.GasUsed
+	if minGasUsed > maxGasUsed {
+		minGasUsed, maxGasUsed = maxGasUsed, minGasUsed
+	}
+
+	// the limits apply to each tx of the bundle
+	for _, result := range simulate(newSuite(baseapp.QueryLimits{GasLimit: maxGasUsed}), 2) {
+		require.NoError(t, result.Err)
+	}
+
+	results = simulate(newSuite(baseapp.QueryLimits{GasLimit: minGasUsed - 1}), 1)
+	require.ErrorIs(t, results[0].Err, sdkerrors.ErrOutOfGas)
+
+	results = simulate(newSuite(baseapp.QueryLimits{Timeout: time.Nanosecond}), 1)
+	require.ErrorIs(t, results[0].Err, sdkerrors.ErrQueryTimeout)
+}
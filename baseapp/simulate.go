@@ -0,0 +1,185 @@
+package baseapp
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// SimulateOnBranch executes a tx in simulate mode on top of ms with the given
+// block header, instead of the check state. Unlike Simulate, the state changes
+// of the tx are written to ms, so that callers can inspect them: the AnteHandler
+// changes are always written, the message changes only if the tx succeeds.
+//
+// ms must therefore be a throwaway branch, e.g. one obtained from
+// CacheMultiStoreWithVersion. It is meant for offline tooling, such as
+// simulating a tx against an exported or historical state.
+func (app *BaseApp) SimulateOnBranch(ms storetypes.CacheMultiStore, header cmtproto.Header, txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	ctx := sdk.NewContext(ms, header, true, app.logger).
+		WithMinGasPrices(app.minGasPrices).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.WithKVGasSchedule(app.GetGasSchedule(ctx))
+
+	gasInfo, result, _, _, err := app.runTxWithContext(ctx, runTxModeSimulateBranch, txBytes)
+	return gasInfo, result, err
+}
+
+// StateOverrider applies the account overrides of SimulateBundle. It is
+// implemented by the application, which knows the modules storing the
+// accounts and their balances.
+type StateOverrider interface {
+	// OverrideBalances sets the balances of addr in the denoms of balances.
+	OverrideBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
+	// OverrideSequence sets the sequence of the account of addr, creating the
+	// account if it does not exist.
+	OverrideSequence(ctx sdk.Context, addr sdk.AccAddress, sequence uint64) error
+}
+
+// simulateBundleMethod is the gRPC method of the bundle simulations, whose
+// query limits bound each tx of a bundle.
+const simulateBundleMethod = "/cosmos.tx.v1beta1.Service/SimulateBundle"
+
+// BundleTxResult is the outcome of a tx simulated by SimulateBundle.
+type BundleTxResult struct {
+	GasInfo sdk.GasInfo
+	Result  *sdk.Result
+	Err     error
+}
+
+// SimulateBundle simulates txs in order on a throwaway branch of the check
+// state, after applying overrides to the branch. Each tx sees the state changes
+// of the previous ones: the AnteHandler changes of a failed tx are kept, as in
+// a block.
+//
+// Unless verifySignatures is set, the txs are simulated as by Simulate,
+// without verifying their signatures. Otherwise, the AnteHandler and the
+// PostHandler run as in DeliverTx, verifying the signatures and charging their
+// exact gas.
+//
+// Each tx is bounded by the gas limit and the timeout of the query limits of
+// the SimulateBundle gRPC method, failing with an ErrOutOfGas or an
+// ErrQueryTimeout error when it exceeds them.
+//
+// An error is returned if the overrides cannot be applied, the errors of the
+// txs are reported in their results.
+func (app *BaseApp) SimulateBundle(txs [][]byte, overrides txtypes.StateOverrides, verifySignatures bool) ([]BundleTxResult, error) {
+	mode := runTxModeSimulateBranch
+	if verifySignatures {
+		mode = runTxModeSimulateSigned
+	}
+
+	checkCtx := app.checkState.ctx
+	ctx := checkCtx.
+		WithMultiStore(checkCtx.MultiStore().CacheMultiStore()).
+		WithVoteInfos(app.voteInfos).
+		WithEventManager(sdk.NewEventManager())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	if verifySignatures {
+		// the txs run as in DeliverTx, so that the AnteHandler decorators do not
+		// update the state of the node kept for CheckTx.
+		ctx = ctx.WithIsCheckTx(false)
+	}
+
+	if err := app.applyStateOverrides(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), overrides); err != nil {
+		return nil, err
+	}
+
+	limits := app.queryLimits(simulateBundleMethod)
+	results := make([]BundleTxResult, len(txs))
+	for i, txBytes := range txs {
+		results[i] = app.simulateBundleTx(ctx, mode, txBytes, limits)
+	}
+
+	return results, nil
+}
+
+// simulateBundleTx simulates a tx of a bundle within limits.
+func (app *BaseApp) simulateBundleTx(ctx sdk.Context, mode runTxMode, txBytes []byte, limits QueryLimits) BundleTxResult {
+	ctx, queryGasMeter, cancel := withQueryLimits(ctx, limits)
+	defer cancel()
+
+	txCtx := contextWithQueryGasMeter(ctx, queryGasMeter).
+		WithTxBytes(txBytes).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	gasInfo, result, _, _, err := app.runTxWithContext(txCtx, mode, txBytes)
+	return BundleTxResult{GasInfo: gasInfo, Result: result, Err: err}
+}
+
+// applyStateOverrides applies the store overrides, then the balance and the
+// sequence overrides to the multistore of ctx.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides txtypes.StateOverrides) error {
+	if len(overrides.Stores) > 0 {
+		cms, ok := app.cms.(interface {
+			StoreKeysByName() map[string]storetypes.StoreKey
+		})
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "store overrides are not supported by %T", app.cms)
+		}
+
+		keys := cms.StoreKeysByName()
+		for _, o := range overrides.Stores {
+			key, ok := keys[o.StoreKey]
+			if !ok {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown store %q", o.StoreKey)
+			}
+			if len(o.Key) == 0 {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty key in store %q override", o.StoreKey)
+			}
+
+			store := ctx.KVStore(key)
+			switch {
+			case o.Delete:
+				store.Delete(o.Key)
+			case o.Value == nil:
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nil value for key %X in store %q override", o.Key, o.StoreKey)
+			default:
+				store.Set(o.Key, o.Value)
+			}
+		}
+	}
+
+	if len(overrides.Balances) == 0 && len(overrides.Sequences) == 0 {
+		return nil
+	}
+	if app.stateOverrider == nil {
+		return errorsmod.Wrap(sdkerrors.ErrNotSupported, "the application does not support account overrides")
+	}
+
+	for _, o := range overrides.Balances {
+		addr, err := sdk.AccAddressFromBech32(o.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "balance override: %s", err)
+		}
+		// a zero amount overrides a balance to zero
+		for _, coin := range o.Coins {
+			if err := coin.Validate(); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance override of %s: %s", o.Address, err)
+			}
+		}
+		if err := app.stateOverrider.OverrideBalances(ctx, addr, o.Coins); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range overrides.Sequences {
+		addr, err := sdk.AccAddressFromBech32(o.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "sequence override: %s", err)
+		}
+		if err := app.stateOverrider.OverrideSequence(ctx, addr, o.Sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
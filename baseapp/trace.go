@@ -0,0 +1,349 @@
+package baseapp
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TraceStepKind is the kind of a step of a TxTrace.
+type TraceStepKind string
+
+const (
+	TraceStepAnteEnter TraceStepKind = "ante_enter"
+	TraceStepAnteExit  TraceStepKind = "ante_exit"
+	TraceStepMsgEnter  TraceStepKind = "msg_enter"
+	TraceStepMsgExit   TraceStepKind = "msg_exit"
+	TraceStepPostEnter TraceStepKind = "post_enter"
+	TraceStepPostExit  TraceStepKind = "post_exit"
+	TraceStepKV        TraceStepKind = "kv"
+	TraceStepEvent     TraceStepKind = "event"
+)
+
+// KV operations of the TraceStepKV steps.
+const (
+	TraceOpGet             = "get"
+	TraceOpSet             = "set"
+	TraceOpHas             = "has"
+	TraceOpDelete          = "delete"
+	TraceOpIterator        = "iterator"
+	TraceOpReverseIterator = "reverse_iterator"
+	TraceOpNext            = "next"
+)
+
+// TraceStep is a step of the execution of a traced tx: the boundary of an
+// ante or post decorator or of a msg, a KV operation or an emitted event.
+type TraceStep struct {
+	Kind TraceStepKind `json:"kind"`
+	// Depth is the number of decorators and msgs the step is nested in.
+	Depth int `json:"depth"`
+	// Name is the type of the decorator, the type URL of the msg or the KV
+	// operation.
+	Name string `json:"name,omitempty"`
+	// GasConsumed is the gas consumed by the tx at a decorator or msg boundary.
+	GasConsumed uint64 `json:"gas_consumed,omitempty"`
+	// Error is the error returned by a decorator or a msg.
+	Error string `json:"error,omitempty"`
+
+	// Store is the name of the store key of a KV operation, Key and Value the
+	// pair read or written. The Key and Value of an iterator operation are the
+	// pair it points to afterwards, Start and End its domain.
+	Store string            `json:"store,omitempty"`
+	Key   cmtbytes.HexBytes `json:"key,omitempty"`
+	Value cmtbytes.HexBytes `json:"value,omitempty"`
+	Start cmtbytes.HexBytes `json:"start,omitempty"`
+	End   cmtbytes.HexBytes `json:"end,omitempty"`
+	// Decoded is the description of the pair by the decoder of the store. It
+	// is not set by TraceTx, but by the tooling knowing the decoders.
+	Decoded string `json:"decoded,omitempty"`
+	// Gas is the gas charged by the gas KVStore for the operation, computed
+	// from the gas configuration of the store.
+	Gas uint64 `json:"gas,omitempty"`
+
+	Event *abci.Event `json:"event,omitempty"`
+}
+
+// TxTrace is the outcome of a traced tx, along with the steps of its
+// execution.
+type TxTrace struct {
+	GasWanted uint64       `json:"gas_wanted"`
+	GasUsed   uint64       `json:"gas_used"`
+	Codespace string       `json:"codespace,omitempty"`
+	Code      uint32       `json:"code,omitempty"`
+	Log       string       `json:"log,omitempty"`
+	Events    []abci.Event `json:"events"`
+	Steps     []TraceStep  `json:"steps"`
+}
+
+// TraceTx executes the txs prevTxs and then traces the execution of the tx
+// txBytes, in deliver mode on top of ms with the given block header. ms is
+// expected to be a throwaway branch of the state committed before the block,
+// e.g. one obtained from CacheMultiStoreWithVersion, and prevTxs the txs of
+// the block preceding the traced one. BeginBlock is not executed.
+//
+// The tracer is set in the context of the traced tx only and the txs are not
+// removed from the mempool, so it can run while the app processes blocks.
+func (app *BaseApp) TraceTx(ms storetypes.CacheMultiStore, header cmtproto.Header, prevTxs [][]byte, txBytes []byte) *TxTrace {
+	ctx := sdk.NewContext(ms, header, false, app.logger).
+		WithVoteInfos(app.voteInfos)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.WithKVGasSchedule(app.GetGasSchedule(ctx))
+	ctx = ctx.WithBlockGasMeter(app.getBlockGasMeter(ctx))
+
+	for _, tx := range prevTxs {
+		// the failed txs are part of the block too, their errors are ignored.
+		txCtx := ctx.WithTxBytes(tx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, _, _, _, _ = app.runTxWithContext(txCtx, runTxModeTrace, tx) //nolint:dogsled
+	}
+
+	tracer := &txTracer{
+		gasSchedule:        ctx.KVGasSchedule(),
+		transientGasConfig: ctx.TransientKVGasConfig(),
+		emitted:            make(map[sdk.EventManagerI]int),
+	}
+
+	ctx = ctx.WithTxBytes(txBytes).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithMultiStore(traceMultiStore{cacheMultiStore: ms, tracer: tracer})
+	ctx = sdk.ContextWithDecoratorTracer(ctx, tracer)
+	ctx = contextWithMsgInterceptor(ctx, tracer.interceptMsg)
+
+	gInfo, result, anteEvents, _, err := app.runTxWithContext(ctx, runTxModeTrace, txBytes)
+
+	trace := &TxTrace{
+		GasWanted: gInfo.GasWanted,
+		GasUsed:   gInfo.GasUsed,
+		Events:    anteEvents,
+		Steps:     tracer.steps,
+	}
+	if err != nil {
+		trace.Codespace, trace.Code, trace.Log = errorsmod.ABCIInfo(err, false)
+	} else {
+		trace.Log = result.Log
+		trace.Events = result.Events
+	}
+	if trace.Events == nil {
+		trace.Events = []abci.Event{}
+	}
+
+	return trace
+}
+
+// txTracer records the steps of the execution of a tx. It is notified of the
+// decorator boundaries by the decorator chains, of the msg boundaries by the
+// msg service router and of the KV operations by the traceKVStores.
+type txTracer struct {
+	gasSchedule        sdk.GasSchedule
+	transientGasConfig storetypes.GasConfig
+
+	steps []TraceStep
+	depth int
+	// emitted is the number of events of each event manager already recorded.
+	emitted map[sdk.EventManagerI]int
+}
+
+var _ sdk.DecoratorTracer = (*txTracer)(nil)
+
+func (t *txTracer) add(step TraceStep) {
+	step.Depth = t.depth
+	t.steps = append(t.steps, step)
+}
+
+// recordEvents records the events emitted to the event manager of ctx since
+// the last call.
+func (t *txTracer) recordEvents(ctx sdk.Context) {
+	em := ctx.EventManager()
+	if em == nil {
+		return
+	}
+
+	events := em.ABCIEvents()
+	for i := t.emitted[em]; i < len(events); i++ {
+		t.add(TraceStep{Kind: TraceStepEvent, Event: &events[i]})
+	}
+	t.emitted[em] = len(events)
+}
+
+func (t *txTracer) EnterDecorator(ctx sdk.Context, chain, decorator string) {
+	kind := TraceStepAnteEnter
+	if chain == sdk.PostDecoratorChain {
+		kind = TraceStepPostEnter
+	}
+
+	t.recordEvents(ctx)
+	t.add(TraceStep{Kind: kind, Name: decorator, GasConsumed: ctx.GasMeter().GasConsumed()})
+	t.depth++
+}
+
+func (t *txTracer) ExitDecorator(ctx sdk.Context, chain, decorator string, err error) {
+	kind := TraceStepAnteExit
+	if chain == sdk.PostDecoratorChain {
+		kind = TraceStepPostExit
+	}
+
+	t.recordEvents(ctx)
+	t.depth--
+	t.add(TraceStep{Kind: kind, Name: decorator, GasConsumed: ctx.GasMeter().GasConsumed(), Error: errorString(err)})
+}
+
+// interceptMsg is the MsgInterceptor recording the msg boundaries.
+func (t *txTracer) interceptMsg(ctx sdk.Context, msg sdk.Msg, next MsgHandler) (proto.Message, error) {
+	name := sdk.MsgTypeURL(msg)
+	t.add(TraceStep{Kind: TraceStepMsgEnter, Name: name, GasConsumed: ctx.GasMeter().GasConsumed()})
+	t.depth++
+
+	res, err := next(ctx, msg)
+
+	t.recordEvents(ctx)
+	t.depth--
+	t.add(TraceStep{Kind: TraceStepMsgExit, Name: name, GasConsumed: ctx.GasMeter().GasConsumed(), Error: errorString(err)})
+
+	return res, err
+}
+
+// kvGasConfig returns the gas configuration charged by the gas KVStore of key.
+func (t *txTracer) kvGasConfig(key storetypes.StoreKey) storetypes.GasConfig {
+	if _, ok := key.(*storetypes.TransientStoreKey); ok {
+		return t.transientGasConfig
+	}
+
+	return t.gasSchedule.GasConfig(key)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// traceMultiStore is a CacheMultiStore whose KVStores, and the ones of its
+// branches, record their operations to a txTracer. The context wraps them in
+// gas KVStores, so the operations recorded are the ones charged gas.
+type traceMultiStore struct {
+	cacheMultiStore
+	tracer *txTracer
+}
+
+// cacheMultiStore allows traceMultiStore to embed a CacheMultiStore while
+// overriding its CacheMultiStore method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+func (ms traceMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &traceKVStore{
+		KVStore:   ms.cacheMultiStore.GetKVStore(key),
+		key:       key,
+		gasConfig: ms.tracer.kvGasConfig(key),
+		tracer:    ms.tracer,
+	}
+}
+
+func (ms traceMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return traceMultiStore{cacheMultiStore: ms.cacheMultiStore.CacheMultiStore(), tracer: ms.tracer}
+}
+
+// traceKVStore records the operations on a KVStore, along with the gas the gas
+// KVStore charges for them.
+type traceKVStore struct {
+	storetypes.KVStore
+	key       storetypes.StoreKey
+	gasConfig storetypes.GasConfig
+	tracer    *txTracer
+}
+
+func (s *traceKVStore) add(op string, key, value []byte, gas storetypes.Gas) {
+	s.tracer.add(TraceStep{
+		Kind:  TraceStepKV,
+		Name:  op,
+		Store: s.key.Name(),
+		Key:   key,
+		Value: value,
+		Gas:   gas,
+	})
+}
+
+func (s *traceKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.add(TraceOpGet, key, value, s.gasConfig.ReadCostFlat+s.gasConfig.ReadCostPerByte*storetypes.Gas(len(key)+len(value)))
+
+	return value
+}
+
+func (s *traceKVStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.add(TraceOpSet, key, value, s.gasConfig.WriteCostFlat+s.gasConfig.WriteCostPerByte*storetypes.Gas(len(key)+len(value)))
+}
+
+func (s *traceKVStore) Has(key []byte) bool {
+	has := s.KVStore.Has(key)
+	s.add(TraceOpHas, key, nil, s.gasConfig.HasCost)
+
+	return has
+}
+
+func (s *traceKVStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.add(TraceOpDelete, key, nil, s.gasConfig.DeleteCost)
+}
+
+func (s *traceKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(TraceOpIterator, s.KVStore.Iterator(start, end), start, end)
+}
+
+func (s *traceKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(TraceOpReverseIterator, s.KVStore.ReverseIterator(start, end), start, end)
+}
+
+func (s *traceKVStore) iterator(op string, parent storetypes.Iterator, start, end []byte) storetypes.Iterator {
+	it := &traceIterator{Iterator: parent, store: s}
+
+	step := it.step(op, it.seekGas())
+	step.Start, step.End = bytes.Clone(start), bytes.Clone(end)
+	s.tracer.add(step)
+
+	return it
+}
+
+// traceIterator records the moves of an iterator. Like the gas iterator, it
+// charges the pair it points to when moving away from it.
+type traceIterator struct {
+	storetypes.Iterator
+	store *traceKVStore
+}
+
+func (it *traceIterator) Next() {
+	gas := it.seekGas()
+	it.Iterator.Next()
+	it.store.tracer.add(it.step(TraceOpNext, gas))
+}
+
+// seekGas returns the gas charged by the gas iterator to move away from the
+// current pair.
+func (it *traceIterator) seekGas() storetypes.Gas {
+	gas := it.store.gasConfig.IterNextCostFlat
+	if it.Valid() {
+		gas += it.store.gasConfig.ReadCostPerByte * storetypes.Gas(len(it.Key())+len(it.Value()))
+	}
+
+	return gas
+}
+
+// step returns the KV step of op, with the pair the iterator points to.
+func (it *traceIterator) step(op string, gas storetypes.Gas) TraceStep {
+	step := TraceStep{Kind: TraceStepKV, Name: op, Store: it.store.key.Name(), Gas: gas}
+	if it.Valid() {
+		// iterators may reuse the buffers of their pairs.
+		step.Key, step.Value = bytes.Clone(it.Key()), bytes.Clone(it.Value())
+	}
+
+	return step
+}
@@ -0,0 +1,146 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// iterateDecorator iterates over all the pairs of a store.
+type iterateDecorator struct {
+	key storetypes.StoreKey
+}
+
+func (d iterateDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	it := ctx.KVStore(d.key).Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	it.Close()
+
+	return next(ctx, tx, simulate)
+}
+
+// anteHandlerDecorator runs an AnteHandler as a decorator.
+type anteHandlerDecorator struct {
+	anteHandler sdk.AnteHandler
+}
+
+func (d anteHandlerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx, err := d.anteHandler(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	return next(newCtx, tx, simulate)
+}
+
+func TestTraceTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(
+			iterateDecorator{capKey1},
+			anteHandlerDecorator{anteHandlerTxTest(t, capKey1, anteKey)},
+		))
+	}
+	pool := mempool.NewSenderNonceMempool()
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.Commit()
+
+	prevTxBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: prevTxBytes})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 1, pool.CountTx())
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+
+	// the counters of the traced tx are only valid after the previous tx
+	branch := suite.baseApp.CommitMultiStore().CacheMultiStore()
+	trace := suite.baseApp.TraceTx(branch, cmtproto.Header{Height: 2}, [][]byte{prevTxBytes}, txBytes)
+	require.Zero(t, trace.Code, trace.Log)
+	require.NotEmpty(t, trace.Events)
+
+	var (
+		kinds []baseapp.TraceStepKind
+		kvGas uint64
+	)
+	for _, step := range trace.Steps {
+		if step.Kind == baseapp.TraceStepKV {
+			require.Equal(t, capKey1.Name(), step.Store)
+			kvGas += step.Gas
+		}
+		if len(kinds) == 0 || kinds[len(kinds)-1] != step.Kind {
+			kinds = append(kinds, step.Kind)
+		}
+	}
+
+	require.Equal(t, []baseapp.TraceStepKind{
+		baseapp.TraceStepAnteEnter, // iterateDecorator
+		baseapp.TraceStepKV,        // iterator over the counters of the previous tx
+		baseapp.TraceStepAnteEnter, // anteHandlerDecorator
+		baseapp.TraceStepKV,        // ante counter
+		baseapp.TraceStepEvent,
+		baseapp.TraceStepAnteExit,
+		baseapp.TraceStepMsgEnter,
+		baseapp.TraceStepKV, // deliver counter
+		baseapp.TraceStepEvent,
+		baseapp.TraceStepMsgExit,
+	}, kinds)
+
+	// the gas consumed by the tx is the gas of the KV operations, and the
+	// constant gas consumed by the msg handler.
+	require.Equal(t, trace.GasUsed, kvGas+5)
+
+	gasConfig := storetypes.KVGasConfig()
+	iterator := trace.Steps[1]
+	require.Equal(t, baseapp.TraceOpIterator, iterator.Name)
+	require.Equal(t, gasConfig.IterNextCostFlat+gasConfig.ReadCostPerByte*uint64(len(iterator.Key)+len(iterator.Value)), iterator.Gas)
+
+	var set *baseapp.TraceStep
+	for i, step := range trace.Steps {
+		if step.Name == baseapp.TraceOpSet && string(step.Key) == string(deliverKey) {
+			set = &trace.Steps[i]
+		}
+	}
+	require.NotNil(t, set)
+	require.Equal(t, 1, set.Depth)
+	require.Equal(t, gasConfig.WriteCostFlat+gasConfig.WriteCostPerByte*uint64(len(set.Key)+len(set.Value)), set.Gas)
+
+	// the msg failure is reported, along with the ante handler events
+	txBytes, err = suite.txConfig.TxEncoder()(setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 1, 1), true))
+	require.NoError(t, err)
+
+	branch = suite.baseApp.CommitMultiStore().CacheMultiStore()
+	trace = suite.baseApp.TraceTx(branch, cmtproto.Header{Height: 2}, [][]byte{prevTxBytes}, txBytes)
+	require.NotZero(t, trace.Code)
+	require.NotEmpty(t, trace.Events)
+
+	exit := trace.Steps[len(trace.Steps)-1]
+	require.Equal(t, baseapp.TraceStepMsgExit, exit.Kind)
+	require.Contains(t, exit.Error, "message handler failure")
+
+	// the msg service router is not intercepted after the trace
+	branch = suite.baseApp.CommitMultiStore().CacheMultiStore()
+	_, _, err = suite.baseApp.SimulateOnBranch(branch, cmtproto.Header{Height: 2}, prevTxBytes)
+	require.NoError(t, err)
+
+	// the traced txs are not removed from the mempool
+	require.Equal(t, 1, pool.CountTx())
+}
@@ -0,0 +1,518 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+
+	storeiavl "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// storePrefix is the prefix of the keys of the stores of a rootmulti.Store
+// sharing its database, followed by the name of the store and a slash.
+const storePrefix = "s/k:"
+
+// StoreStats are the statistics of the data of a store in the application
+// database.
+type StoreStats struct {
+	Store         string `json:"store"`
+	LatestVersion int64  `json:"latest_version"`
+	Versions      int    `json:"versions"`
+	// Keys is the number of keys of the store at the latest version.
+	Keys int64 `json:"keys"`
+	// Entries and Bytes are the number of database entries of the store and
+	// the size of their keys and values.
+	Entries   int64 `json:"entries"`
+	Bytes     int64 `json:"bytes"`
+	Nodes     int64 `json:"nodes"`
+	FastNodes int64 `json:"fast_nodes"`
+	Orphans   int64 `json:"orphans"`
+}
+
+// OrphanStats are the orphaned nodes of an IAVL store, i.e. the nodes removed
+// from the tree at a version and kept for the previous versions.
+type OrphanStats struct {
+	Store   string `json:"store"`
+	Orphans int64  `json:"orphans"`
+	// Unreachable is the number of orphaned nodes not part of any of the
+	// versions of the store left, e.g. after an interrupted pruning. They are
+	// never deleted by the pruning.
+	Unreachable int64 `json:"unreachable"`
+}
+
+// DBCmd returns the command group inspecting and compacting the application
+// database.
+func DBCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Inspect and compact the application database",
+	}
+
+	cmd.AddCommand(
+		dbStatsCmd(appCreator),
+		dbOrphansCmd(appCreator),
+		dbCompactCmd(),
+	)
+
+	return cmd
+}
+
+func dbStatsCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [home]",
+		Short: "Report the number of keys and the size of the data of each IAVL store",
+		Long: fmt.Sprintf(`Report, for each IAVL store of the application, its versions, its number of keys at
+the latest version, and the number and size of its entries in the application database, by IAVL node kind.
+The sizes are the sizes of the keys and values, before compression by the database. The node must be stopped.
+
+Example:
+$ %s debug db stats ~/.simapp --%s bank,staking
+`, version.AppName, flagStores),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+
+			app, db, err := openAppWithDB(cmd, appCreator, args[0])
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("db stats are only supported for rootmulti.Store")
+			}
+
+			stats := []StoreStats{}
+			for _, name := range storeNames(cms, stores) {
+				st, ok := commitStoreByName(cms, name).(*storeiavl.Store)
+				if !ok {
+					continue
+				}
+
+				storeStats, err := DBStoreStats(cms.StoreDB(cms.StoreKeysByName()[name]), name, st)
+				if err != nil {
+					return fmt.Errorf("store %s: %w", name, err)
+				}
+				stats = append(stats, storeStats)
+			}
+
+			if output == "json" {
+				return printJSON(cmd, stats)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStderr(), 0, 0, 2, ' ', tabwriter.AlignRight)
+			fmt.Fprintln(w, "STORE\tLATEST\tVERSIONS\tKEYS\tENTRIES\tBYTES\tNODES\tFAST NODES\tORPHANS\t")
+			for _, s := range stats {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+					s.Store, s.LatestVersion, s.Versions, s.Keys, s.Entries, s.Bytes, s.Nodes, s.FastNodes, s.Orphans)
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStores, nil, "Only report the given stores")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database of the application")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func dbOrphansCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orphans [home]",
+		Short: "Report the number of orphaned nodes of each IAVL store",
+		Long: fmt.Sprintf(`Report, for each IAVL store of the application, the number of orphaned nodes, i.e.
+the nodes removed from the tree and kept for the previous versions, and how many of them are not part of any
+version left. Unreachable orphans are left behind by an interrupted pruning and are never deleted. The node
+must be stopped.
+
+Example:
+$ %s debug db orphans ~/.simapp
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+
+			app, db, err := openAppWithDB(cmd, appCreator, args[0])
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("db orphans are only supported for rootmulti.Store")
+			}
+
+			stats := []OrphanStats{}
+			for _, name := range storeNames(cms, stores) {
+				st, ok := commitStoreByName(cms, name).(*storeiavl.Store)
+				if !ok {
+					continue
+				}
+
+				orphans, err := DBStoreOrphans(cms.StoreDB(cms.StoreKeysByName()[name]), name, st.GetAllVersions())
+				if err != nil {
+					return fmt.Errorf("store %s: %w", name, err)
+				}
+				stats = append(stats, orphans)
+			}
+
+			if output == "json" {
+				return printJSON(cmd, stats)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStderr(), 0, 0, 2, ' ', tabwriter.AlignRight)
+			fmt.Fprintln(w, "STORE\tORPHANS\tUNREACHABLE\t")
+			for _, s := range stats {
+				fmt.Fprintf(w, "%s\t%d\t%d\t\n", s.Store, s.Orphans, s.Unreachable)
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStores, nil, "Only report the given stores")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database of the application")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func dbCompactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact [home]",
+		Short: "Compact the application database",
+		Long: fmt.Sprintf(`Compact the application database, store by store, to reclaim the disk space of the
+data deleted by the pruning. Compaction is supported by the goleveldb and pebbledb backends. The node must be
+stopped.
+
+Example:
+$ %s debug db compact ~/.simapp
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			db, err := openDB(vp, args[0])
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			dir := filepath.Join(args[0], "data", "application.db")
+			before, err := dirSize(dir)
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			err = CompactDB(db, stores, func(name string, i, n int, elapsed time.Duration) {
+				cmd.Printf("[%d/%d] compacted %s in %s\n", i, n, name, elapsed.Round(time.Millisecond))
+			})
+			if err != nil {
+				return err
+			}
+
+			after, err := dirSize(dir)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("compacted %s in %s: %d bytes -> %d bytes\n", dir, time.Since(start).Round(time.Millisecond), before, after)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStores, nil, "Only compact the given stores")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database of the application")
+
+	return cmd
+}
+
+// DBStoreStats returns the statistics of the data of the IAVL store of the
+// given name, given the database of the store returned by
+// rootmulti.Store.StoreDB.
+func DBStoreStats(db dbm.DB, name string, st *storeiavl.Store) (StoreStats, error) {
+	if err := checkIAVLLayout(); err != nil {
+		return StoreStats{}, err
+	}
+
+	stats := StoreStats{
+		Store:         name,
+		LatestVersion: st.LastCommitID().Version,
+		Versions:      len(st.GetAllVersions()),
+	}
+
+	if stats.LatestVersion > 0 {
+		latest, err := st.GetImmutable(stats.LatestVersion)
+		if err != nil {
+			return stats, err
+		}
+
+		it := latest.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			stats.Keys++
+		}
+		if err := it.Close(); err != nil {
+			return stats, err
+		}
+	}
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return stats, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		stats.Entries++
+		stats.Bytes += int64(len(key) + len(it.Value()))
+
+		switch string(key[:1]) {
+		case iavlNodeKeyFormat.Prefix():
+			stats.Nodes++
+		case iavlFastNodeKeyFormat.Prefix():
+			stats.FastNodes++
+		case iavlOrphanKeyFormat.Prefix():
+			stats.Orphans++
+		}
+	}
+
+	return stats, it.Error()
+}
+
+// DBStoreOrphans returns the orphaned nodes of the IAVL store of the given
+// name, given the database of the store returned by rootmulti.Store.StoreDB
+// and the versions of the store.
+func DBStoreOrphans(db dbm.DB, name string, versions []int) (OrphanStats, error) {
+	stats := OrphanStats{Store: name}
+	if err := checkIAVLLayout(); err != nil {
+		return stats, err
+	}
+
+	prefix := []byte(iavlOrphanKeyFormat.Prefix())
+	it, err := db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return stats, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		// the orphan is part of the versions from first to last included.
+		first, last, err := iavlOrphanVersions(it.Key())
+		if err != nil {
+			return stats, err
+		}
+
+		stats.Orphans++
+
+		i := sort.SearchInts(versions, int(first))
+		if i == len(versions) || int64(versions[i]) > last {
+			stats.Unreachable++
+		}
+	}
+
+	return stats, it.Error()
+}
+
+// CompactDB compacts the data of the stores of the given names in db, or of
+// all the stores found in db if names is empty, followed by the rest of the
+// database when all the stores are compacted. progress is called after each
+// step. The stores mounted with their own database are not part of db.
+// Compaction is only supported by the goleveldb and pebbledb backends.
+func CompactDB(db dbm.DB, names []string, progress func(name string, i, n int, elapsed time.Duration)) error {
+	compact, err := compactFunc(db)
+	if err != nil {
+		return err
+	}
+
+	all, err := dbStoreNames(db)
+	if err != nil {
+		return err
+	}
+
+	var steps []string
+	for _, name := range all {
+		if len(names) == 0 || slices.Contains(names, name) {
+			steps = append(steps, name)
+		}
+	}
+
+	n := len(steps)
+	if len(names) == 0 {
+		n++
+	}
+
+	for i, name := range steps {
+		start := time.Now()
+		prefix := storeKeyPrefix(name)
+		if err := compact(prefix, storetypes.PrefixEndBytes(prefix)); err != nil {
+			return fmt.Errorf("store %s: %w", name, err)
+		}
+		progress(name, i+1, n, time.Since(start))
+	}
+
+	if len(names) > 0 {
+		return nil
+	}
+
+	// compact the whole key range, which only rewrites the data outside of
+	// the stores, e.g. the commit infos, as the stores are already compacted.
+	first, last, err := keyRange(db)
+	if err != nil || first == nil {
+		return err
+	}
+
+	start := time.Now()
+	if err := compact(first, append(last, 0)); err != nil {
+		return err
+	}
+	progress("remaining data", n, n, time.Since(start))
+
+	return nil
+}
+
+// compactor is implemented by the database backends supporting the compaction
+// of a key range.
+type compactor interface {
+	ForceCompact(start, limit []byte) error
+}
+
+// compactFunc returns the function compacting a key range of db.
+func compactFunc(db dbm.DB) (func(start, end []byte) error, error) {
+	if db, ok := db.(compactor); ok {
+		return db.ForceCompact, nil
+	}
+	if compact := pebbleCompactFunc(db); compact != nil {
+		return compact, nil
+	}
+
+	return nil, fmt.Errorf("compaction is not supported by the %T database backend", db)
+}
+
+// dbStoreNames returns the sorted names of the stores with data in db.
+func dbStoreNames(db dbm.DB) ([]string, error) {
+	var names []string
+
+	start := []byte(storePrefix)
+	end := storetypes.PrefixEndBytes(start)
+	for {
+		it, err := db.Iterator(start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		var key []byte
+		if it.Valid() {
+			key = it.Key()[len(storePrefix):]
+		}
+		if err := it.Close(); err != nil {
+			return nil, err
+		}
+		if key == nil {
+			break
+		}
+
+		i := slices.Index(key, '/')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid store key %q", key)
+		}
+		name := string(key[:i])
+		names = append(names, name)
+
+		// skip to the next store.
+		start = storetypes.PrefixEndBytes(storeKeyPrefix(name))
+	}
+
+	return names, nil
+}
+
+// keyRange returns the first and last keys of db, nil if it is empty.
+func keyRange(db dbm.DB) (first, last []byte, err error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if it.Valid() {
+		first = slices.Clone(it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	rit, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rit.Valid() {
+		last = slices.Clone(rit.Key())
+	}
+
+	return first, last, rit.Close()
+}
+
+// storeKeyPrefix returns the prefix of the keys of the store of the given name
+// in the database of a rootmulti.Store.
+func storeKeyPrefix(name string) []byte {
+	return []byte(storePrefix + name + "/")
+}
+
+// dirSize returns the size of the files of a directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+
+		return nil
+	})
+
+	return size, err
+}
+
+func printJSON(cmd *cobra.Command, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+
+	return nil
+}
@@ -0,0 +1,12 @@
+//go:build !pebbledb
+
+package debug
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// pebbleCompactFunc returns nil, pebbledb is not built in.
+func pebbleCompactFunc(dbm.DB) func(start, end []byte) error {
+	return nil
+}
@@ -0,0 +1,20 @@
+//go:build pebbledb
+
+package debug
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// pebbleCompactFunc returns the function compacting a key range of db if it
+// is a pebbledb database, nil otherwise.
+func pebbleCompactFunc(db dbm.DB) func(start, end []byte) error {
+	pdb, ok := db.(*dbm.PebbleDB)
+	if !ok {
+		return nil
+	}
+
+	return func(start, end []byte) error {
+		return pdb.DB().Compact(start, end, true)
+	}
+}
@@ -0,0 +1,108 @@
+package debug
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storeiavl "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBStats(t *testing.T) {
+	db, err := dbm.NewGoLevelDB("application", t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	bankKey := storetypes.NewKVStoreKey("bank")
+	accKey := storetypes.NewKVStoreKey("acc")
+	ownKey := storetypes.NewKVStoreKey("own")
+
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.SetPruning(pruningtypes.NewCustomPruningOptions(2, 1))
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(ownKey, storetypes.StoreTypeIAVL, dbm.NewMemDB())
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		bank := cms.GetKVStore(bankKey)
+		bank.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		bank.Set([]byte("balance"), []byte(fmt.Sprint(i)))
+		if i > 0 {
+			bank.Delete([]byte(fmt.Sprintf("key%d", i-1)))
+		}
+		cms.GetKVStore(accKey).Set([]byte("acc"), []byte("value"))
+		cms.GetKVStore(ownKey).Set([]byte("own"), []byte(fmt.Sprint(i)))
+		cms.Commit()
+	}
+
+	bank := cms.GetCommitKVStore(bankKey).(*storeiavl.Store)
+	bankDB := cms.StoreDB(bankKey)
+	stats, err := DBStoreStats(bankDB, "bank", bank)
+	require.NoError(t, err)
+	require.Equal(t, "bank", stats.Store)
+	require.Equal(t, int64(10), stats.LatestVersion)
+	require.Equal(t, len(bank.GetAllVersions()), stats.Versions)
+	require.Equal(t, int64(2), stats.Keys)
+	require.Equal(t, int64(2), stats.FastNodes)
+	require.Positive(t, stats.Nodes)
+	require.Positive(t, stats.Orphans)
+	require.Greater(t, stats.Entries, stats.Nodes+stats.FastNodes+stats.Orphans)
+	require.Positive(t, stats.Bytes)
+
+	orphans, err := DBStoreOrphans(bankDB, "bank", bank.GetAllVersions())
+	require.NoError(t, err)
+	require.Equal(t, stats.Orphans, orphans.Orphans)
+	require.Zero(t, orphans.Unreachable)
+
+	// an orphan of the pruned version 1 left behind.
+	key := iavlOrphanKeyFormat.Key(int64(1), int64(1), make([]byte, 32))
+	require.NoError(t, bankDB.Set(key, make([]byte, 32)))
+
+	orphans, err = DBStoreOrphans(bankDB, "bank", bank.GetAllVersions())
+	require.NoError(t, err)
+	require.Equal(t, stats.Orphans+1, orphans.Orphans)
+	require.Equal(t, int64(1), orphans.Unreachable)
+
+	// a store mounted with its own database.
+	own := cms.GetCommitKVStore(ownKey).(*storeiavl.Store)
+	ownStats, err := DBStoreStats(cms.StoreDB(ownKey), "own", own)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ownStats.Keys)
+	require.Equal(t, int64(1), ownStats.FastNodes)
+	require.Positive(t, ownStats.Nodes)
+
+	names, err := dbStoreNames(db)
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc", "bank"}, names)
+
+	var steps []string
+	err = CompactDB(db, nil, func(name string, i, n int, _ time.Duration) {
+		require.Equal(t, len(steps)+1, i)
+		require.Equal(t, 3, n)
+		steps = append(steps, name)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc", "bank", "remaining data"}, steps)
+
+	steps = nil
+	err = CompactDB(db, []string{"bank"}, func(name string, _, _ int, _ time.Duration) {
+		steps = append(steps, name)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank"}, steps)
+
+	restats, err := DBStoreStats(bankDB, "bank", bank)
+	require.NoError(t, err)
+	require.Equal(t, stats.Keys, restats.Keys)
+
+	err = CompactDB(dbm.NewMemDB(), nil, func(string, int, int, time.Duration) {})
+	require.ErrorContains(t, err, "compaction is not supported")
+}
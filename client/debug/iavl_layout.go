@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/cosmos/iavl/keyformat"
+)
+
+// The statistics of the IAVL stores decode the node database of IAVL, whose
+// layout is not exposed by its API. The key formats below are the ones of the
+// node database of the IAVL versions of iavlLayoutVersions, see nodedb.go.
+var (
+	iavlNodeKeyFormat     = keyformat.NewKeyFormat('n', 32)       // n<hash>
+	iavlOrphanKeyFormat   = keyformat.NewKeyFormat('o', 8, 8, 32) // o<last-version><first-version><hash>
+	iavlFastNodeKeyFormat = keyformat.NewKeyFormat('f', 0)        // f<key>
+)
+
+// iavlLayoutVersions are the prefixes of the IAVL versions whose node database
+// layout is decoded.
+var iavlLayoutVersions = []string{"v0.19.", "v0.20.", "v0.21."}
+
+// checkIAVLLayout returns an error if the IAVL version the binary is built
+// with has a node database layout which is not decoded.
+func checkIAVLLayout() error {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path != "github.com/cosmos/iavl" {
+			continue
+		}
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		if dep.Version == "" {
+			// replaced by a local directory, the version is unknown.
+			return nil
+		}
+
+		for _, version := range iavlLayoutVersions {
+			if strings.HasPrefix(dep.Version, version) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("the node database layout of IAVL %s is not supported", dep.Version)
+	}
+
+	return nil
+}
+
+// iavlOrphanVersions returns the first and last versions an orphaned node is
+// part of, given its key in the node database.
+func iavlOrphanVersions(key []byte) (first, last int64, err error) {
+	if len(iavlOrphanKeyFormat.ScanBytes(key)) != 3 {
+		return 0, 0, fmt.Errorf("invalid orphan key %X", key)
+	}
+
+	iavlOrphanKeyFormat.Scan(key, &last, &first)
+
+	return first, last, nil
+}
@@ -0,0 +1,507 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/iavl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/dbadapter"
+	storeiavl "cosmossdk.io/store/iavl"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeightA = "height-a"
+	flagHeightB = "height-b"
+	flagSince   = "since"
+	flagStores  = "stores"
+)
+
+// DiffKind is the kind of difference of a key between two states.
+type DiffKind string
+
+const (
+	DiffAdded   DiffKind = "added"
+	DiffRemoved DiffKind = "removed"
+	DiffChanged DiffKind = "changed"
+)
+
+// KeyDiff is the difference of a key of a store between a state A and a state B.
+type KeyDiff struct {
+	Store   string            `json:"store"`
+	Kind    DiffKind          `json:"kind"`
+	Key     cmtbytes.HexBytes `json:"key"`
+	A       cmtbytes.HexBytes `json:"a,omitempty"`
+	B       cmtbytes.HexBytes `json:"b,omitempty"`
+	Decoded string            `json:"decoded,omitempty"`
+}
+
+// StateDiffCmd returns the command comparing the application state of two
+// heights of a node, or of two nodes.
+func StateDiffCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [home-a] [home-b]",
+		Short: "Report the added, removed and changed keys between two application states",
+		Long: fmt.Sprintf(`Report the added, removed and changed keys of each IAVL store between two application states:
+two heights of the node home given as single argument, or the states of the two node homes given as arguments.
+The nodes must be stopped.
+
+With a single home, '--%[1]s' is required and '--%[2]s' defaults to the latest height. The differences are
+computed from the IAVL version diffs, without walking the whole stores.
+
+With two homes, the heights default to the latest height of each home. Stores with equal root hashes are
+skipped, the others are walked entirely, unless '--%[3]s' is set to a height at which both states were
+identical, e.g. the last height before an AppHash mismatch, in which case only the keys changed since are
+compared.
+
+Keys and values are decoded with the store decoders registered by the modules, when available.
+
+Example:
+$ %[4]s debug state-diff ~/.simapp --%[1]s 100 --%[2]s 101
+$ %[4]s debug state-diff ~/node-a ~/node-b --%[3]s 99 --%[5]s bank,staking
+`, flagHeightA, flagHeightB, flagSince, version.AppName, flagStores),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			heightA, _ := cmd.Flags().GetInt64(flagHeightA)
+			heightB, _ := cmd.Flags().GetInt64(flagHeightB)
+			since, _ := cmd.Flags().GetInt64(flagSince)
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+
+			appA, err := openApp(cmd, appCreator, args[0])
+			if err != nil {
+				return err
+			}
+			appB := appA
+			if len(args) == 2 {
+				if appB, err = openApp(cmd, appCreator, args[1]); err != nil {
+					return err
+				}
+			}
+
+			decoders := storeDecoders(appA)
+
+			cmsA, ok := appA.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("state diff is only supported for rootmulti.Store")
+			}
+			cmsB, ok := appB.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("state diff is only supported for rootmulti.Store")
+			}
+
+			if heightB == 0 {
+				heightB = cmsB.LastCommitID().Version
+			}
+			if len(args) == 1 {
+				if heightA <= 0 || heightA >= heightB {
+					return fmt.Errorf("--%s must be set to a height lower than %d", flagHeightA, heightB)
+				}
+			} else if heightA == 0 {
+				heightA = cmsA.LastCommitID().Version
+			}
+
+			var diffs []KeyDiff
+			for _, name := range storeNames(cmsA, stores) {
+				storeA, okA := commitStoreByName(cmsA, name).(*storeiavl.Store)
+				storeB, okB := commitStoreByName(cmsB, name).(*storeiavl.Store)
+				if !okA || !okB {
+					continue
+				}
+
+				var storeDiffs []KeyDiff
+				switch {
+				case len(args) == 1:
+					storeDiffs, err = DiffVersions(name, storeA, heightA, heightB)
+				case since > 0:
+					storeDiffs, err = DiffSince(name, storeA, heightA, storeB, heightB, since)
+				default:
+					storeDiffs, err = DiffStores(name, storeA, heightA, storeB, heightB)
+				}
+				if err != nil {
+					return fmt.Errorf("store %s: %w", name, err)
+				}
+
+				decodeDiffs(decoders, storeDiffs)
+				diffs = append(diffs, storeDiffs...)
+			}
+
+			return printDiffs(cmd, output, diffs)
+		},
+	}
+
+	cmd.Flags().Int64(flagHeightA, 0, "The height of state A, the latest height if 0 and two homes are given")
+	cmd.Flags().Int64(flagHeightB, 0, "The height of state B, the latest height if 0")
+	cmd.Flags().Int64(flagSince, 0, "A height at which both states were identical, to only compare the keys changed since")
+	cmd.Flags().StringSlice(flagStores, nil, "Only compare the given stores")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database of the application")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// openApp creates the application from the data of the given home directory.
+func openApp(cmd *cobra.Command, appCreator servertypes.AppCreator, home string) (servertypes.Application, error) {
+	app, _, err := openAppWithDB(cmd, appCreator, home)
+	return app, err
+}
+
+// openAppWithDB creates the application from the data of the given home
+// directory, and returns it with its database.
+func openAppWithDB(cmd *cobra.Command, appCreator servertypes.AppCreator, home string) (servertypes.Application, dbm.DB, error) {
+	vp := viper.New()
+	if err := vp.BindPFlags(cmd.Flags()); err != nil {
+		return nil, nil, err
+	}
+	vp.Set(flags.FlagHome, home)
+	// the application is only read, nothing must be pruned.
+	vp.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+	db, err := openDB(vp, home)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return appCreator(log.NewNopLogger(), db, nil, vp), db, nil
+}
+
+// openDB opens the application database of the given home directory.
+func openDB(vp *viper.Viper, home string) (dbm.DB, error) {
+	return dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+}
+
+// storeDecoders returns the store decoders registered by the modules of the
+// app, if it has a simulation manager.
+func storeDecoders(app servertypes.Application) simulation.StoreDecoderRegistry {
+	if app, ok := app.(interface {
+		SimulationManager() *module.SimulationManager
+	}); ok && app.SimulationManager() != nil {
+		return app.SimulationManager().StoreDecoders
+	}
+
+	return nil
+}
+
+// storeNames returns the sorted names of the stores of cms, restricted to
+// filter if not empty.
+func storeNames(cms *rootmulti.Store, filter []string) []string {
+	var names []string
+	for name := range cms.StoreKeysByName() {
+		if len(filter) > 0 && !slices.Contains(filter, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// commitStoreByName returns the underlying commit store of the given name, not
+// wrapped by the inter-block cache.
+func commitStoreByName(cms *rootmulti.Store, name string) storetypes.CommitKVStore {
+	key, ok := cms.StoreKeysByName()[name]
+	if !ok {
+		return nil
+	}
+
+	return cms.GetCommitKVStore(key)
+}
+
+// DiffVersions returns the differences of the store between versions from and
+// to, computed from the IAVL state changes of the versions in between.
+func DiffVersions(name string, st *storeiavl.Store, from, to int64) ([]KeyDiff, error) {
+	a, err := versionStore(st, from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := versionStore(st, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if !st.VersionExists(from) {
+		// the store was added after from, e.g. by an upgrade.
+		return diffIterators(name, a, b), nil
+	}
+
+	keys, err := changedKeys(st, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffKeys(name, a, b, keys), nil
+}
+
+// DiffSince returns the differences of the stores A and B at the given
+// versions, comparing only the keys changed since version since, at which
+// both stores are expected to be identical.
+func DiffSince(name string, stA *storeiavl.Store, versionA int64, stB *storeiavl.Store, versionB, since int64) ([]KeyDiff, error) {
+	a, err := versionStore(stA, versionA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := versionStore(stB, versionB)
+	if err != nil {
+		return nil, err
+	}
+
+	if equalHashes(a, b) {
+		return nil, nil
+	}
+
+	keysA, err := changedKeys(stA, since, versionA)
+	if err != nil {
+		return nil, err
+	}
+	keysB, err := changedKeys(stB, since, versionB)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffKeys(name, a, b, mergeKeys(keysA, keysB)), nil
+}
+
+// DiffStores returns the differences of the stores A and B at the given
+// versions, walking both stores entirely unless their root hashes are equal.
+func DiffStores(name string, stA *storeiavl.Store, versionA int64, stB *storeiavl.Store, versionB int64) ([]KeyDiff, error) {
+	a, err := versionStore(stA, versionA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := versionStore(stB, versionB)
+	if err != nil {
+		return nil, err
+	}
+
+	if equalHashes(a, b) {
+		return nil, nil
+	}
+
+	return diffIterators(name, a, b), nil
+}
+
+// versionStore returns the store at the given version, or an empty store if
+// the store did not exist yet at this version, e.g. when added by an upgrade.
+func versionStore(st *storeiavl.Store, version int64) (storetypes.KVStore, error) {
+	if !st.VersionExists(version) {
+		versions := st.GetAllVersions()
+		if len(versions) == 0 || version >= int64(versions[0]) {
+			return nil, fmt.Errorf("version %d does not exist, it has either been pruned or is for a future height", version)
+		}
+
+		return dbadapter.Store{DB: dbm.NewMemDB()}, nil
+	}
+
+	return st.GetImmutable(version)
+}
+
+func equalHashes(a, b storetypes.KVStore) bool {
+	stA, okA := a.(*storeiavl.Store)
+	stB, okB := b.(*storeiavl.Store)
+
+	return okA && okB && bytes.Equal(stA.LastCommitID().Hash, stB.LastCommitID().Hash)
+}
+
+// changedKeys returns the sorted keys changed by the versions after from, up
+// to version to included.
+func changedKeys(st *storeiavl.Store, from, to int64) ([][]byte, error) {
+	changed := make(map[string]struct{})
+	err := st.TraverseStateChanges(from+1, to+1, func(_ int64, changeSet *iavl.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			changed[string(pair.Key)] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(changed))
+	for key := range changed {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+
+	return keys, nil
+}
+
+// mergeKeys merges two sorted key lists, without duplicates.
+func mergeKeys(a, b [][]byte) [][]byte {
+	keys := make([][]byte, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || (i < len(a) && bytes.Compare(a[i], b[j]) < 0):
+			keys = append(keys, a[i])
+			i++
+		case i == len(a) || bytes.Compare(a[i], b[j]) > 0:
+			keys = append(keys, b[j])
+			j++
+		default:
+			keys = append(keys, a[i])
+			i++
+			j++
+		}
+	}
+
+	return keys
+}
+
+// diffKeys compares the values of the given keys in a and b.
+func diffKeys(name string, a, b storetypes.KVStore, keys [][]byte) []KeyDiff {
+	var diffs []KeyDiff
+	for _, key := range keys {
+		if diff, ok := diffValues(name, key, a.Get(key), b.Get(key)); ok {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs
+}
+
+// diffIterators walks a and b in order and compares all their keys.
+func diffIterators(name string, a, b storetypes.KVStore) []KeyDiff {
+	itA := a.Iterator(nil, nil)
+	defer itA.Close()
+	itB := b.Iterator(nil, nil)
+	defer itB.Close()
+
+	var diffs []KeyDiff
+	for itA.Valid() || itB.Valid() {
+		var cmp int
+		switch {
+		case !itB.Valid():
+			cmp = -1
+		case !itA.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(itA.Key(), itB.Key())
+		}
+
+		var diff KeyDiff
+		ok := true
+		switch {
+		case cmp < 0:
+			diff, ok = diffValues(name, itA.Key(), itA.Value(), nil)
+			itA.Next()
+		case cmp > 0:
+			diff, ok = diffValues(name, itB.Key(), nil, itB.Value())
+			itB.Next()
+		default:
+			diff, ok = diffValues(name, itA.Key(), itA.Value(), itB.Value())
+			itA.Next()
+			itB.Next()
+		}
+
+		if ok {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs
+}
+
+// diffValues returns the difference of the values of key, a nil value meaning
+// the key does not exist, and false if the values are equal.
+func diffValues(name string, key, a, b []byte) (KeyDiff, bool) {
+	diff := KeyDiff{Store: name, Key: key, A: a, B: b}
+	switch {
+	case (a == nil) == (b == nil) && bytes.Equal(a, b):
+		return KeyDiff{}, false
+	case a == nil:
+		diff.Kind = DiffAdded
+	case b == nil:
+		diff.Kind = DiffRemoved
+	default:
+		diff.Kind = DiffChanged
+	}
+
+	return diff, true
+}
+
+// decodeDiffs sets the decoded description of the diffs of the stores with a
+// registered decoder.
+func decodeDiffs(decoders simulation.StoreDecoderRegistry, diffs []KeyDiff) {
+	for i, diff := range diffs {
+		decoder, ok := decoders[diff.Store]
+		if !ok {
+			continue
+		}
+
+		diffs[i].Decoded = decode(decoder, diff)
+	}
+}
+
+// decode calls the decoder of the store, which panics on keys it does not
+// know or on missing values.
+func decode(decoder func(kvA, kvB kv.Pair) string, diff KeyDiff) (decoded string) {
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+
+	return decoder(kv.Pair{Key: diff.Key, Value: diff.A}, kv.Pair{Key: diff.Key, Value: diff.B})
+}
+
+func printDiffs(cmd *cobra.Command, output string, diffs []KeyDiff) error {
+	if output == "json" {
+		if diffs == nil {
+			diffs = []KeyDiff{}
+		}
+
+		bz, err := json.MarshalIndent(diffs, "", "  ")
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(bz))
+		return nil
+	}
+
+	if len(diffs) == 0 {
+		cmd.Println("no differences")
+		return nil
+	}
+
+	for _, diff := range diffs {
+		cmd.Printf("%s %s %X\n", diff.Store, diff.Kind, diff.Key)
+		if diff.Decoded != "" {
+			cmd.Println(indent(diff.Decoded))
+			continue
+		}
+		if diff.A != nil {
+			cmd.Printf("  A: %X\n", diff.A)
+		}
+		if diff.B != nil {
+			cmd.Printf("  B: %X\n", diff.B)
+		}
+	}
+
+	return nil
+}
+
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(strings.TrimSpace(s), "\n", "\n  ")
+}
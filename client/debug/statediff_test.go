@@ -0,0 +1,83 @@
+package debug
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storeiavl "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newIAVLStore(t *testing.T) *storeiavl.Store {
+	t.Helper()
+
+	st, err := storeiavl.LoadStore(dbm.NewMemDB(), log.NewNopLogger(), storetypes.NewKVStoreKey("test"), storetypes.CommitID{}, false, storeiavl.DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+
+	return st.(*storeiavl.Store)
+}
+
+func TestDiffVersions(t *testing.T) {
+	st := newIAVLStore(t)
+	st.Set([]byte("changed"), []byte("a"))
+	st.Set([]byte("removed"), []byte("a"))
+	st.Set([]byte("reverted"), []byte("a"))
+	st.Commit() // 1
+
+	st.Set([]byte("changed"), []byte("b"))
+	st.Set([]byte("reverted"), []byte("b"))
+	st.Commit() // 2
+
+	st.Delete([]byte("removed"))
+	st.Set([]byte("added"), []byte("b"))
+	st.Set([]byte("reverted"), []byte("a"))
+	st.Commit() // 3
+
+	diffs, err := DiffVersions("test", st, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, []KeyDiff{
+		{Store: "test", Kind: DiffAdded, Key: []byte("added"), B: []byte("b")},
+		{Store: "test", Kind: DiffChanged, Key: []byte("changed"), A: []byte("a"), B: []byte("b")},
+		{Store: "test", Kind: DiffRemoved, Key: []byte("removed"), A: []byte("a")},
+	}, diffs)
+
+	_, err = DiffVersions("test", st, 1, 4)
+	require.Error(t, err)
+}
+
+func TestDiffStores(t *testing.T) {
+	stA, stB := newIAVLStore(t), newIAVLStore(t)
+	for _, st := range []*storeiavl.Store{stA, stB} {
+		st.Set([]byte("common"), []byte("a"))
+		st.Set([]byte("diverged"), []byte("a"))
+		st.Commit()
+	}
+
+	// identical stores have no differences
+	diffs, err := DiffStores("test", stA, 1, stB, 1)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	stA.Set([]byte("diverged"), []byte("b"))
+	stA.Set([]byte("only-a"), []byte("a"))
+	stA.Commit()
+	stB.Set([]byte("only-b"), []byte("b"))
+	stB.Commit()
+
+	expected := []KeyDiff{
+		{Store: "test", Kind: DiffChanged, Key: []byte("diverged"), A: []byte("b"), B: []byte("a")},
+		{Store: "test", Kind: DiffRemoved, Key: []byte("only-a"), A: []byte("a")},
+		{Store: "test", Kind: DiffAdded, Key: []byte("only-b"), B: []byte("b")},
+	}
+
+	diffs, err = DiffStores("test", stA, 2, stB, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, diffs)
+
+	diffs, err = DiffSince("test", stA, 2, stB, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, expected, diffs)
+}
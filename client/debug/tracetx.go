@@ -0,0 +1,286 @@
+package debug
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/node"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagHeight = "height"
+
+// TxTracer is implemented by applications able to trace the execution of a
+// tx, such as the ones embedding a *baseapp.BaseApp.
+type TxTracer interface {
+	TraceTx(ms storetypes.CacheMultiStore, header cmtproto.Header, prevTxs [][]byte, txBytes []byte) *baseapp.TxTrace
+}
+
+// TxTraceReport is the trace of a tx re-executed at its height.
+type TxTraceReport struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Height int64             `json:"height"`
+	// Index is the index of the tx in its block, the txs before it being
+	// executed before the trace.
+	Index uint32            `json:"index"`
+	Msgs  []json.RawMessage `json:"msgs"`
+	// Committed is the result of the tx committed by the chain, to compare with
+	// the re-execution. It is not set for txs read from a file.
+	Committed *CommittedTxResult `json:"committed,omitempty"`
+	Trace     *baseapp.TxTrace   `json:"trace"`
+}
+
+// CommittedTxResult is the result of a tx committed by the chain.
+type CommittedTxResult struct {
+	GasWanted int64  `json:"gas_wanted"`
+	GasUsed   int64  `json:"gas_used"`
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	Log       string `json:"log,omitempty"`
+}
+
+// TraceTxCmd returns the command re-executing a tx of a node and reporting
+// the trace of its execution.
+func TraceTxCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx [hash|tx-file]",
+		Short: "Re-execute a transaction on a branch of the state of its height and print the trace of its execution",
+		Long: fmt.Sprintf(`Re-execute a transaction on a branch of the application state of its height and print the trace of its
+execution as JSON: the boundaries of the ante and post decorators and of the messages, every KV operation with its
+store key, its key and value, decoded by the store decoders of the modules when available, and the gas charged for
+it, and the emitted events.
+
+The transaction is either the committed transaction of the given hex hash, looked up in the transaction index of the
+node, or the JSON encoded transaction of the given file. A committed transaction is executed on the state of the
+previous height, after the transactions preceding it in its block, and its committed result is reported along with
+the trace. A transaction file is executed at the beginning of the block '--%[1]s', the next block by default.
+
+The BeginBlock of the block is not executed: the state changes of the BeginBlockers, e.g. the minted coins, are not
+seen by the transactions. Nothing is persisted, and the node must be stopped.
+
+Example:
+$ %[2]s debug trace-tx 4B5D...A2F1
+$ %[2]s debug trace-tx tx.json --%[1]s 100
+`, flagHeight, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cfg := server.GetServerContextFromCmd(cmd).Config
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+
+			report := &TxTraceReport{}
+			var txBytes []byte
+			if _, err := os.Stat(args[0]); err == nil {
+				if txBytes, err = readTx(clientCtx.TxConfig, args[0]); err != nil {
+					return err
+				}
+				if height == 0 {
+					height = blockStore.Height() + 1
+				}
+			} else {
+				hash, err := hex.DecodeString(args[0])
+				if err != nil {
+					return fmt.Errorf("%s is neither a file nor a tx hash", args[0])
+				}
+
+				txResult, err := committedTx(cfg.TxIndex.Indexer, &node.DBContext{ID: "tx_index", Config: cfg}, hash)
+				if err != nil {
+					return err
+				}
+
+				txBytes, height, report.Index = txResult.Tx, txResult.Height, txResult.Index
+				report.Committed = &CommittedTxResult{
+					GasWanted: txResult.Result.GasWanted,
+					GasUsed:   txResult.Result.GasUsed,
+					Codespace: txResult.Result.Codespace,
+					Code:      txResult.Result.Code,
+					Log:       txResult.Result.Log,
+				}
+			}
+
+			header, prevTxs, err := blockContext(blockStore, height, report.Index)
+			if err != nil {
+				return err
+			}
+
+			report.Hash = cmttypes.Tx(txBytes).Hash()
+			report.Height = height
+			if report.Msgs, err = msgsJSON(clientCtx, txBytes); err != nil {
+				return err
+			}
+
+			app, db, err := openAppWithDB(cmd, appCreator, cfg.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if report.Trace, err = TraceTx(app, header, prevTxs, txBytes); err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height of the block a transaction file is executed at, the next block if 0")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database of the application")
+
+	return cmd
+}
+
+// TraceTx traces the execution of txBytes by app in the block of the given
+// header, after the txs prevTxs of the block, on a throwaway branch of the
+// state committed at the previous height. The KV operations of the trace are
+// decoded with the store decoders of the app, when available.
+func TraceTx(app servertypes.Application, header cmtproto.Header, prevTxs [][]byte, txBytes []byte) (*baseapp.TxTrace, error) {
+	tracer, ok := app.(TxTracer)
+	if !ok {
+		return nil, errors.New("the application does not support tracing transactions")
+	}
+
+	ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(header.Height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", header.Height-1, err)
+	}
+
+	trace := tracer.TraceTx(ms, header, prevTxs, txBytes)
+	decodeSteps(storeDecoders(app), trace.Steps)
+
+	return trace, nil
+}
+
+// committedTx looks up the committed tx of the given hash in the tx index of
+// the node.
+func committedTx(indexer string, dbCtx *node.DBContext, hash []byte) (*abci.TxResult, error) {
+	if indexer != "kv" {
+		return nil, fmt.Errorf("looking up a tx by hash requires the kv tx indexer, the node uses %q", indexer)
+	}
+
+	db, err := node.DefaultDBProvider(dbCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	txResult, err := kv.NewTxIndex(db).Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if txResult == nil {
+		return nil, fmt.Errorf("tx %X not found in the tx index", hash)
+	}
+
+	return txResult, nil
+}
+
+// blockContext returns the header of the block at height and its txs before
+// index. A block following the last block of the store, not committed yet,
+// is given the chain ID and the time of the last block, and no txs.
+func blockContext(blockStore *cmtstore.BlockStore, height int64, index uint32) (cmtproto.Header, [][]byte, error) {
+	if height == blockStore.Height()+1 {
+		last := blockStore.LoadBlockMeta(blockStore.Height())
+		if last == nil {
+			return cmtproto.Header{}, nil, errors.New("the block store is empty")
+		}
+
+		return cmtproto.Header{ChainID: last.Header.ChainID, Height: height, Time: last.Header.Time}, nil, nil
+	}
+
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return cmtproto.Header{}, nil, fmt.Errorf("block %d not found, the block store has the blocks %d to %d", height, blockStore.Base(), blockStore.Height())
+	}
+	if int(index) > len(block.Txs) {
+		return cmtproto.Header{}, nil, fmt.Errorf("block %d has %d txs, tx index %d is out of range", height, len(block.Txs), index)
+	}
+
+	prevTxs := make([][]byte, index)
+	for i := range prevTxs {
+		prevTxs[i] = block.Txs[i]
+	}
+
+	return *block.Header.ToProto(), prevTxs, nil
+}
+
+// msgsJSON returns the JSON encoding of the msgs of the tx.
+func msgsJSON(clientCtx client.Context, txBytes []byte) ([]json.RawMessage, error) {
+	tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	msgs := make([]json.RawMessage, 0, len(tx.GetMsgs()))
+	for _, msg := range tx.GetMsgs() {
+		bz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, bz)
+	}
+
+	return msgs, nil
+}
+
+// readTx reads a JSON encoded tx from file and returns its binary encoding.
+func readTx(txConfig client.TxConfig, file string) ([]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := txConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	return txConfig.TxEncoder()(tx)
+}
+
+// decodeSteps sets the decoded description of the KV operations on the
+// stores with a registered decoder.
+func decodeSteps(decoders simulation.StoreDecoderRegistry, steps []baseapp.TraceStep) {
+	for i, step := range steps {
+		if step.Kind != baseapp.TraceStepKV || step.Key == nil {
+			continue
+		}
+
+		decoder, ok := decoders[step.Store]
+		if !ok {
+			continue
+		}
+
+		steps[i].Decoded = decode(decoder, KeyDiff{Key: step.Key, A: step.Value, B: step.Value})
+	}
+}
@@ -0,0 +1,38 @@
+package debug
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestDecodeSteps(t *testing.T) {
+	decoders := simulation.StoreDecoderRegistry{
+		"bank": func(kvA, kvB kv.Pair) string {
+			if string(kvA.Key) != "balance" {
+				panic("unknown key")
+			}
+			return fmt.Sprintf("%s: %s", kvA.Key, kvA.Value)
+		},
+	}
+
+	steps := []baseapp.TraceStep{
+		{Kind: baseapp.TraceStepAnteEnter, Name: "ante.SetUpContextDecorator"},
+		{Kind: baseapp.TraceStepKV, Name: baseapp.TraceOpGet, Store: "bank", Key: []byte("balance"), Value: []byte("10")},
+		{Kind: baseapp.TraceStepKV, Name: baseapp.TraceOpSet, Store: "bank", Key: []byte("unknown"), Value: []byte("10")},
+		{Kind: baseapp.TraceStepKV, Name: baseapp.TraceOpGet, Store: "acc", Key: []byte("balance")},
+		{Kind: baseapp.TraceStepKV, Name: baseapp.TraceOpIterator, Store: "bank"},
+	}
+	decodeSteps(decoders, steps)
+
+	decoded := make([]string, len(steps))
+	for i, step := range steps {
+		decoded[i] = step.Decoded
+	}
+	require.Equal(t, []string{"", "balance: 10", "", "", ""}, decoded)
+}
@@ -0,0 +1,542 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagQuery             = "query"
+	flagReconnectInterval = "reconnect-interval"
+	flagMaxEvents         = "max-events"
+
+	// subscriberName is the subscriber identifier sent to CometBFT. CometBFT
+	// overrides it with the remote address, it is only kept for logging.
+	subscriberName = "cosmos-sdk-events-subscribe"
+
+	// Event sources reported in StreamedEvent.Source.
+	EventSourceTx         = "tx"
+	EventSourceBeginBlock = "begin_block"
+	EventSourceEndBlock   = "end_block"
+
+	txSearchPerPage = 100
+)
+
+// StreamedEvent is a single ABCI event received from a CometBFT event
+// subscription. Typed events (ADR-032) are additionally decoded into their
+// proto message and stored in Typed as JSON.
+type StreamedEvent struct {
+	Height     int64                 `json:"height"`
+	TxHash     string                `json:"txhash,omitempty"`
+	Source     string                `json:"source"`
+	Type       string                `json:"type"`
+	Attributes []abci.EventAttribute `json:"attributes"`
+	Typed      json.RawMessage       `json:"typed,omitempty"`
+}
+
+// EventsCommand returns the command group for CometBFT event subscriptions.
+func EventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "events",
+		Short:                      "Subscribe to chain events",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(SubscribeEventsCmd())
+
+	return cmd
+}
+
+// SubscribeEventsCmd returns a command that streams the ABCI events matching
+// a CometBFT query as JSON lines.
+func SubscribeEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "Stream the events matching a CometBFT query as JSON lines",
+		Long: `Open a CometBFT websocket subscription and stream every matching ABCI event as
+one JSON object per line. Typed events (ADR-032) are also decoded into their proto message.
+
+The subscription is re-established automatically when the connection drops. For transaction
+and block queries, events emitted while disconnected are replayed from the last seen height.`,
+		Example: fmt.Sprintf(`$ %[1]s events subscribe --query "tm.event='Tx' AND transfer.recipient='cosmos1...'"
+$ %[1]s events subscribe --query "tm.event='NewBlock'"`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			query, _ := cmd.Flags().GetString(flagQuery)
+			if strings.TrimSpace(query) == "" {
+				return fmt.Errorf("--%s flag is required", flagQuery)
+			}
+
+			reconnect, _ := cmd.Flags().GetDuration(flagReconnectInterval)
+			maxEvents, _ := cmd.Flags().GetUint64(flagMaxEvents)
+			startHeight, _ := cmd.Flags().GetInt64(flags.FlagHeight)
+
+			logger := log.NewLogger(cmd.ErrOrStderr())
+			return StreamEvents(cmd.Context(), clientCtx, logger, query, startHeight, reconnect, newJSONLinesHandler(cmd.OutOrStdout(), maxEvents))
+		},
+	}
+
+	cmd.Flags().String(flagQuery, "", "CometBFT event query, e.g. \"tm.event='Tx' AND message.sender='cosmos1...'\"")
+	cmd.Flags().Duration(flagReconnectInterval, 5*time.Second, "Interval between connection health checks and reconnection attempts")
+	cmd.Flags().Uint64(flagMaxEvents, 0, "Stop after streaming this many events (0 streams forever)")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Replay transaction or block events from this height before streaming new ones")
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+
+	return cmd
+}
+
+// errStopStreaming is returned by an event handler to stop streaming without
+// reporting an error.
+var errStopStreaming = errors.New("stop streaming")
+
+// newJSONLinesHandler returns an event handler writing each event as a JSON
+// line to w. It stops the stream after maxEvents events, if maxEvents > 0.
+func newJSONLinesHandler(w io.Writer, maxEvents uint64) func(StreamedEvent) error {
+	var count uint64
+	enc := json.NewEncoder(w)
+
+	return func(event StreamedEvent) error {
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+
+		count++
+		if maxEvents > 0 && count >= maxEvents {
+			return errStopStreaming
+		}
+
+		return nil
+	}
+}
+
+// StreamEvents subscribes to the given CometBFT query on the node configured
+// in clientCtx and calls handle for every received event, until ctx is done or
+// handle returns an error.
+//
+// The connection health is checked every reconnect interval, and the
+// subscription is re-established when it fails, which is reported to logger.
+// Transaction events are resumed from the last seen height (or from
+// startHeight on the first connection) using the transaction index, and block
+// events using the block results, so no transaction or block is skipped or
+// repeated across reconnections.
+func StreamEvents(
+	ctx context.Context,
+	clientCtx client.Context,
+	logger log.Logger,
+	query string,
+	startHeight int64,
+	reconnect time.Duration,
+	handle func(StreamedEvent) error,
+) error {
+	if clientCtx.NodeURI == "" {
+		return errors.New("no CometBFT RPC node configured")
+	}
+
+	if reconnect <= 0 {
+		return fmt.Errorf("reconnect interval must be positive, got %s", reconnect)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	s := &eventStreamer{
+		clientCtx:  clientCtx,
+		query:      query,
+		txQuery:    isTxQuery(query),
+		blockEvent: blockQueryEvent(query),
+		handle:     handle,
+		seen:       make(map[string]struct{}),
+	}
+	if startHeight > 0 {
+		s.resumeHeight = startHeight
+	}
+
+	for {
+		err := s.stream(ctx, reconnect)
+		switch {
+		case errors.Is(err, errStopStreaming):
+			return nil
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && !isConnectionError(err):
+			return err
+		}
+
+		if err != nil {
+			logger.Error("event subscription lost", "err", err, "reconnect_in", reconnect)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnect):
+		}
+	}
+}
+
+// connectionError wraps errors related to the RPC connection, after which the
+// stream is re-established.
+type connectionError struct{ err error }
+
+func (e connectionError) Error() string { return e.err.Error() }
+func (e connectionError) Unwrap() error { return e.err }
+
+func isConnectionError(err error) bool {
+	var connErr connectionError
+	return errors.As(err, &connErr)
+}
+
+// eventStreamer keeps the resume state of an event stream across
+// reconnections.
+type eventStreamer struct {
+	clientCtx client.Context
+	query     string
+	txQuery   bool
+	handle    func(StreamedEvent) error
+
+	// blockEvent is the CometBFT block event (NewBlock or NewBlockHeader)
+	// matched by the query, empty if the query does not match block events.
+	blockEvent string
+
+	// resumeHeight is the height from which transaction or block events are
+	// replayed when (re)connecting, 0 if nothing must be replayed.
+	resumeHeight int64
+	// lastHeight is the height of the last transaction streamed and seen holds
+	// the hashes of the transactions already streamed at that height.
+	lastHeight int64
+	seen       map[string]struct{}
+}
+
+// stream opens a single websocket connection and streams events until the
+// connection fails, ctx is done or the handler returns an error.
+func (s *eventStreamer) stream(ctx context.Context, healthInterval time.Duration) error {
+	node, err := client.NewClientFromNode(s.clientCtx.NodeURI)
+	if err != nil {
+		return err
+	}
+
+	if err := node.Start(); err != nil {
+		return connectionError{err}
+	}
+	defer node.Stop() //nolint:errcheck // nothing to do on a failed stop
+
+	events, err := node.Subscribe(ctx, subscriberName, s.query, 100)
+	if err != nil {
+		return connectionError{err}
+	}
+	defer node.UnsubscribeAll(context.Background(), subscriberName) //nolint:errcheck // the connection is closed anyway
+
+	if s.resumeHeight > 0 {
+		switch {
+		case s.txQuery:
+			err = s.replayTxs(ctx, node)
+		case s.blockEvent != "":
+			err = s.replayBlocks(ctx, node)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			if _, err := node.Status(ctx); err != nil {
+				return connectionError{err}
+			}
+
+		case res, ok := <-events:
+			if !ok {
+				return connectionError{errors.New("event subscription closed")}
+			}
+
+			if err := s.handleResult(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replayTxs streams the indexed transactions matching the query from the
+// resume height onwards.
+func (s *eventStreamer) replayTxs(ctx context.Context, node *rpchttp.HTTP) error {
+	query := txSearchQuery(s.query, s.resumeHeight)
+
+	for page := 1; ; page++ {
+		perPage := txSearchPerPage
+		res, err := node.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return connectionError{err}
+		}
+
+		for _, tx := range res.Txs {
+			if err := s.handleTx(tx.Height, tx.Hash.String(), tx.TxResult.Events); err != nil {
+				return err
+			}
+		}
+
+		if page*perPage >= res.TotalCount || len(res.Txs) == 0 {
+			return nil
+		}
+	}
+}
+
+// replayBlocks streams the events of the blocks matching the query from the
+// resume height up to the latest block.
+func (s *eventStreamer) replayBlocks(ctx context.Context, node *rpchttp.HTTP) error {
+	query, err := cmtquery.New(s.query)
+	if err != nil {
+		return err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return connectionError{err}
+	}
+
+	for height := s.resumeHeight; height <= status.SyncInfo.LatestBlockHeight; height++ {
+		h := height
+		res, err := node.BlockResults(ctx, &h)
+		if err != nil {
+			return connectionError{err}
+		}
+
+		matches, err := query.Matches(blockEventsMap(s.blockEvent, res.BeginBlockEvents, res.EndBlockEvents))
+		if err != nil {
+			return err
+		}
+		if !matches {
+			s.resumeHeight = height + 1
+			continue
+		}
+
+		if err := s.handleBlock(height, res.BeginBlockEvents, res.EndBlockEvents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// blockEventsMap returns the events of a block in the form matched by
+// CometBFT queries, as published by the CometBFT event bus.
+func blockEventsMap(blockEvent string, beginBlock, endBlock []abci.Event) map[string][]string {
+	events := map[string][]string{cmttypes.EventTypeKey: {blockEvent}}
+	for _, event := range append(append([]abci.Event{}, beginBlock...), endBlock...) {
+		if event.Type == "" {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == "" {
+				continue
+			}
+
+			key := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			events[key] = append(events[key], attr.Value)
+		}
+	}
+
+	return events
+}
+
+// handleResult streams the events contained in a subscription result.
+func (s *eventStreamer) handleResult(res coretypes.ResultEvent) error {
+	switch data := res.Data.(type) {
+	case cmttypes.EventDataTx:
+		hash := fmt.Sprintf("%X", cmttypes.Tx(data.Tx).Hash())
+		return s.handleTx(data.Height, hash, data.Result.Events)
+
+	case cmttypes.EventDataNewBlock:
+		return s.handleBlock(data.Block.Height, data.ResultBeginBlock.Events, data.ResultEndBlock.Events)
+
+	case cmttypes.EventDataNewBlockHeader:
+		return s.handleBlock(data.Header.Height, data.ResultBeginBlock.Events, data.ResultEndBlock.Events)
+
+	default:
+		// Other CometBFT events (votes, round steps, ...) do not carry ABCI
+		// events.
+		return nil
+	}
+}
+
+// handleTx streams the events of a transaction, unless it was already
+// streamed, and records it as the resume point.
+func (s *eventStreamer) handleTx(height int64, hash string, events []abci.Event) error {
+	if height < s.lastHeight {
+		return nil
+	}
+
+	if height > s.lastHeight {
+		s.lastHeight = height
+		s.seen = make(map[string]struct{})
+	}
+
+	if _, ok := s.seen[hash]; ok {
+		return nil
+	}
+	s.seen[hash] = struct{}{}
+	s.resumeHeight = height
+
+	return s.emit(height, hash, EventSourceTx, events)
+}
+
+// handleBlock streams the events of a block, unless it was already streamed,
+// and records the next block as the resume point.
+func (s *eventStreamer) handleBlock(height int64, beginBlock, endBlock []abci.Event) error {
+	if height < s.resumeHeight {
+		return nil
+	}
+	s.resumeHeight = height + 1
+
+	if err := s.emit(height, "", EventSourceBeginBlock, beginBlock); err != nil {
+		return err
+	}
+
+	return s.emit(height, "", EventSourceEndBlock, endBlock)
+}
+
+func (s *eventStreamer) emit(height int64, hash, source string, events []abci.Event) error {
+	for _, event := range FormatEvents(s.clientCtx, height, hash, source, events) {
+		if err := s.handle(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FormatEvents converts ABCI events into StreamedEvents, decoding typed
+// events into their proto message JSON representation.
+func FormatEvents(clientCtx client.Context, height int64, hash, source string, events []abci.Event) []StreamedEvent {
+	out := make([]StreamedEvent, 0, len(events))
+	for _, event := range events {
+		out = append(out, StreamedEvent{
+			Height:     height,
+			TxHash:     hash,
+			Source:     source,
+			Type:       event.Type,
+			Attributes: event.Attributes,
+			Typed:      decodeTypedEvent(clientCtx, event),
+		})
+	}
+
+	return out
+}
+
+// decodeTypedEvent returns the JSON representation of the proto message of a
+// typed event, or nil if the event is not a typed event.
+func decodeTypedEvent(clientCtx client.Context, event abci.Event) json.RawMessage {
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, clientCtx.InterfaceRegistry)
+	if err != nil {
+		return nil
+	}
+
+	return bz
+}
+
+// isTxQuery returns true if the query only matches transaction events.
+func isTxQuery(query string) bool {
+	for _, cond := range splitQuery(query) {
+		compact := strings.Join(strings.Fields(cond), "")
+		if compact == fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventTx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blockQueryEvent returns the block event (NewBlock or NewBlockHeader) matched
+// by the query, or an empty string if the query does not match block events.
+func blockQueryEvent(query string) string {
+	for _, cond := range splitQuery(query) {
+		compact := strings.Join(strings.Fields(cond), "")
+		for _, event := range []string{cmttypes.EventNewBlock, cmttypes.EventNewBlockHeader} {
+			if compact == fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, event) {
+				return event
+			}
+		}
+	}
+
+	return ""
+}
+
+// txSearchQuery converts a subscription query into a transaction index query
+// starting at the given height. The tm.event condition is not indexed and is
+// therefore removed.
+func txSearchQuery(query string, fromHeight int64) string {
+	var conds []string
+	for _, cond := range splitQuery(query) {
+		if strings.HasPrefix(strings.TrimSpace(cond), cmttypes.EventTypeKey) {
+			continue
+		}
+		conds = append(conds, strings.TrimSpace(cond))
+	}
+
+	conds = append(conds, fmt.Sprintf("%s>=%d", cmttypes.TxHeightKey, fromHeight))
+	return strings.Join(conds, " AND ")
+}
+
+// splitQuery splits a CometBFT query into its conditions. Queries only support
+// the AND operator.
+func splitQuery(query string) []string {
+	var (
+		conds []string
+		start int
+	)
+
+	upper := strings.ToUpper(query)
+	inQuote := false
+	for i := 0; i < len(query); i++ {
+		switch {
+		case query[i] == '\'':
+			inQuote = !inQuote
+		case !inQuote && strings.HasPrefix(upper[i:], " AND "):
+			conds = append(conds, query[start:i])
+			start = i + len(" AND ")
+			i = start - 1
+		}
+	}
+
+	return append(conds, query[start:])
+}
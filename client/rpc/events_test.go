@@ -0,0 +1,160 @@
+package rpc
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTxSearchQuery(t *testing.T) {
+	testCases := []struct {
+		name       string
+		query      string
+		isTx       bool
+		blockEvent string
+		txSearch   string
+	}{
+		{
+			name:     "tx event with condition",
+			query:    "tm.event='Tx' AND transfer.recipient='cosmos1abc'",
+			isTx:     true,
+			txSearch: "transfer.recipient='cosmos1abc' AND tx.height>=5",
+		},
+		{
+			name:     "spaces and lower case operator",
+			query:    "tm.event = 'Tx' and message.action='send'",
+			isTx:     true,
+			txSearch: "message.action='send' AND tx.height>=5",
+		},
+		{
+			name:     "quoted AND is not split",
+			query:    "tm.event='Tx' AND memo.text='this AND that'",
+			isTx:     true,
+			txSearch: "memo.text='this AND that' AND tx.height>=5",
+		},
+		{
+			name:       "new block",
+			query:      "tm.event='NewBlock'",
+			isTx:       false,
+			blockEvent: "NewBlock",
+			txSearch:   "tx.height>=5",
+		},
+		{
+			name:       "new block header with condition",
+			query:      "tm.event = 'NewBlockHeader' AND mint.amount='10'",
+			isTx:       false,
+			blockEvent: "NewBlockHeader",
+			txSearch:   "mint.amount='10' AND tx.height>=5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.isTx, isTxQuery(tc.query))
+			require.Equal(t, tc.blockEvent, blockQueryEvent(tc.query))
+			require.Equal(t, tc.txSearch, txSearchQuery(tc.query, 5))
+		})
+	}
+}
+
+func TestFormatEventsDecodesTypedEvents(t *testing.T) {
+	coin := sdk.NewInt64Coin("stake", 10)
+	typed, err := sdk.TypedEventToEvent(&coin)
+	require.NoError(t, err)
+
+	events := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10stake"}}},
+		abci.Event(typed),
+	}
+
+	clientCtx := client.Context{}.WithInterfaceRegistry(codectypes.NewInterfaceRegistry())
+	out := FormatEvents(clientCtx, 3, "ABCD", EventSourceTx, events)
+	require.Len(t, out, 2)
+
+	require.Equal(t, int64(3), out[0].Height)
+	require.Equal(t, "ABCD", out[0].TxHash)
+	require.Equal(t, "transfer", out[0].Type)
+	require.Nil(t, out[0].Typed)
+
+	require.Equal(t, "cosmos.base.v1beta1.Coin", out[1].Type)
+	require.JSONEq(t, `{"denom":"stake","amount":"10"}`, string(out[1].Typed))
+}
+
+func TestEventStreamerDeduplicatesTxs(t *testing.T) {
+	var streamed []StreamedEvent
+	s := &eventStreamer{
+		handle: func(event StreamedEvent) error {
+			streamed = append(streamed, event)
+			return nil
+		},
+		seen: make(map[string]struct{}),
+	}
+
+	events := []abci.Event{{Type: "message"}}
+
+	require.NoError(t, s.handleTx(2, "A", events))
+	require.NoError(t, s.handleTx(2, "B", events))
+	// replayed after a reconnection
+	require.NoError(t, s.handleTx(2, "A", events))
+	require.NoError(t, s.handleTx(1, "C", events))
+	require.NoError(t, s.handleTx(3, "D", events))
+
+	require.Len(t, streamed, 3)
+	require.Equal(t, []string{"A", "B", "D"}, []string{streamed[0].TxHash, streamed[1].TxHash, streamed[2].TxHash})
+	require.Equal(t, int64(3), s.resumeHeight)
+}
+
+func TestEventStreamerDeduplicatesBlocks(t *testing.T) {
+	var heights []int64
+	s := &eventStreamer{
+		handle: func(event StreamedEvent) error {
+			heights = append(heights, event.Height)
+			return nil
+		},
+	}
+
+	beginBlock := []abci.Event{{Type: "mint"}}
+	endBlock := []abci.Event{{Type: "validator"}}
+
+	require.NoError(t, s.handleBlock(4, beginBlock, endBlock))
+	require.NoError(t, s.handleBlock(5, beginBlock, endBlock))
+	// replayed after a reconnection
+	require.NoError(t, s.handleBlock(5, beginBlock, endBlock))
+	require.NoError(t, s.handleBlock(6, beginBlock, endBlock))
+
+	require.Equal(t, []int64{4, 4, 5, 5, 6, 6}, heights)
+	require.Equal(t, int64(7), s.resumeHeight)
+}
+
+func TestBlockEventsMatchQuery(t *testing.T) {
+	beginBlock := []abci.Event{{Type: "mint", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10"}}}}
+	endBlock := []abci.Event{{Type: "validator", Attributes: []abci.EventAttribute{{Key: "power", Value: "1"}}}}
+
+	events := blockEventsMap("NewBlock", beginBlock, endBlock)
+	require.Equal(t, map[string][]string{
+		"tm.event":        {"NewBlock"},
+		"mint.amount":     {"10"},
+		"validator.power": {"1"},
+	}, events)
+
+	for query, matches := range map[string]bool{
+		"tm.event='NewBlock'":                       true,
+		"tm.event='NewBlock' AND mint.amount='10'":  true,
+		"tm.event='NewBlock' AND mint.amount='20'":  false,
+		"tm.event='NewBlockHeader'":                 false,
+		"tm.event='NewBlock' AND validator.power>0": true,
+	} {
+		q, err := cmtquery.New(query)
+		require.NoError(t, err)
+
+		ok, err := q.Matches(events)
+		require.NoError(t, err)
+		require.Equal(t, matches, ok, query)
+	}
+}
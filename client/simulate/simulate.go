@@ -0,0 +1,340 @@
+package simulate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/listenkv"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	FlagGenesis   = "genesis"
+	FlagBlockTime = "block-time"
+)
+
+// BranchSimulator is implemented by applications able to simulate a tx on a
+// store branch, such as the ones embedding a *baseapp.BaseApp.
+type BranchSimulator interface {
+	SimulateOnBranch(ms storetypes.CacheMultiStore, header cmtproto.Header, txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+}
+
+// Report is the outcome of an offline tx simulation.
+type Report struct {
+	Height       int64          `json:"height"`
+	GasWanted    uint64         `json:"gas_wanted"`
+	GasUsed      uint64         `json:"gas_used"`
+	Events       []abci.Event   `json:"events"`
+	StateChanges []StoreChanges `json:"state_changes"`
+	Codespace    string         `json:"codespace,omitempty"`
+	Code         uint32         `json:"code,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	ErrorStack   string         `json:"error_stack,omitempty"`
+}
+
+// StoreChanges are the state changes of a tx in one store.
+type StoreChanges struct {
+	StoreKey string     `json:"store_key"`
+	Changes  []KVChange `json:"changes"`
+}
+
+// KVChange is the change of the value of a key. Old is empty if the key did
+// not exist and New is empty if the key is deleted.
+type KVChange struct {
+	Key     cmtbytes.HexBytes `json:"key"`
+	Old     cmtbytes.HexBytes `json:"old,omitempty"`
+	New     cmtbytes.HexBytes `json:"new,omitempty"`
+	Deleted bool              `json:"deleted,omitempty"`
+}
+
+// Cmd simulates a tx without a running node, against the state of a local
+// data directory or of a genesis/export JSON file.
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-offline [tx-file]",
+		Short: "Simulate a transaction against a local data directory or an exported state, without a node",
+		Long: `Simulate a transaction against a local data directory or an exported state, without a node.
+The application is loaded from the data directory of '--home', at the height given by '--height'
+(latest by default), or from the genesis/export JSON file given by '--genesis' in an in-memory database.
+
+The transaction is read as JSON, e.g. as generated by '--generate-only', and executed in simulate mode
+on a throwaway branch of the state: nothing is persisted. The report contains the gas used, the events,
+the state changes by store key and, if the transaction fails, the full error stack.
+The node must be stopped when using its data directory.
+`,
+		Example: "simulate-offline tx.json --home ~/.simapp --height 100\nsimulate-offline tx.json --genesis export.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			// the state is never pruned, nothing is committed to the data directory.
+			vp.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+			txBytes, err := readTx(clientCtx.TxConfig, args[0])
+			if err != nil {
+				return err
+			}
+
+			blockTime := time.Now().UTC()
+			if s := vp.GetString(FlagBlockTime); s != "" {
+				if blockTime, err = time.Parse(time.RFC3339, s); err != nil {
+					return fmt.Errorf("invalid block time: %w", err)
+				}
+			}
+
+			logger := log.NewNopLogger()
+
+			var app servertypes.Application
+			if genFile := vp.GetString(FlagGenesis); genFile != "" {
+				tmpHome, err := os.MkdirTemp("", "simulate-offline")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpHome)
+
+				app, err = appFromGenesis(appCreator, logger, vp, genFile, tmpHome)
+				if err != nil {
+					return err
+				}
+			} else {
+				home := vp.GetString(flags.FlagHome)
+				if vp.GetString(flags.FlagChainID) == "" {
+					appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(home, "config", "genesis.json"))
+					if err != nil {
+						return err
+					}
+					vp.Set(flags.FlagChainID, appGenesis.ChainID)
+				}
+
+				db, err := openDB(home, server.GetAppDBBackend(vp))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				app = appCreator(logger, db, nil, vp)
+			}
+
+			report, err := Simulate(app, vp.GetString(flags.FlagChainID), vp.GetInt64(flags.FlagHeight), blockTime, txBytes)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().String(FlagGenesis, "", "Load the state from a genesis or export JSON file instead of the data directory")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "The height of the state to simulate against, 0 for the latest height")
+	cmd.Flags().String(FlagBlockTime, "", "The block time (RFC3339) seen by the transaction, the current time if empty")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID, read from the genesis file if empty")
+
+	return cmd
+}
+
+// Simulate runs txBytes in simulate mode on a throwaway branch of the state of
+// app at the given height, 0 being the latest height, and reports the outcome.
+// An error is returned only if the simulation cannot be run, the tx errors are
+// part of the report.
+func Simulate(app servertypes.Application, chainID string, height int64, blockTime time.Time, txBytes []byte) (*Report, error) {
+	simulator, ok := app.(BranchSimulator)
+	if !ok {
+		return nil, errors.New("the application does not support offline simulation")
+	}
+
+	cms := app.CommitMultiStore()
+	keysProvider, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, fmt.Errorf("offline simulation is not supported for %T multistore", cms)
+	}
+	keys := keysProvider.StoreKeysByName()
+
+	latest := cms.LastCommitID().Version
+	if height == 0 {
+		height = latest
+	}
+	if height <= 0 || height > latest {
+		return nil, fmt.Errorf("invalid height %d, the latest height is %d", height, latest)
+	}
+
+	base, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+	orig, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+
+	listener := storetypes.NewMemoryListener()
+	branch := listeningBranch(base, keys, listener)
+
+	header := cmtproto.Header{ChainID: chainID, Height: height + 1, Time: blockTime}
+	gasInfo, result, simErr := simulator.SimulateOnBranch(branch, header, txBytes)
+
+	// flush the changes of the tx to base, through the listeners.
+	branch.Write()
+
+	report := &Report{
+		Height:       height,
+		GasWanted:    gasInfo.GasWanted,
+		GasUsed:      gasInfo.GasUsed,
+		Events:       []abci.Event{},
+		StateChanges: stateChanges(orig, keys, listener.PopStateCache()),
+	}
+
+	if result != nil {
+		report.Events = result.Events
+	}
+
+	if simErr != nil {
+		report.Codespace, report.Code, _ = errorsmod.ABCIInfo(simErr, false)
+		report.Error = simErr.Error()
+		report.ErrorStack = fmt.Sprintf("%+v", simErr)
+	}
+
+	return report, nil
+}
+
+// listeningBranch returns a branch of ms whose writes are recorded by listener
+// once flushed.
+func listeningBranch(ms storetypes.MultiStore, keys map[string]storetypes.StoreKey, listener *storetypes.MemoryListener) storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		stores[key] = listenkv.NewStore(ms.GetKVStore(key), key, listener)
+	}
+
+	return cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keys, nil, nil)
+}
+
+// stateChanges groups the written pairs by store key, along with their value
+// in orig.
+func stateChanges(orig storetypes.MultiStore, keys map[string]storetypes.StoreKey, pairs []*storetypes.StoreKVPair) []StoreChanges {
+	byStore := make(map[string][]KVChange)
+	for _, pair := range pairs {
+		change := KVChange{
+			Key:     pair.Key,
+			Old:     orig.GetKVStore(keys[pair.StoreKey]).Get(pair.Key),
+			Deleted: pair.Delete,
+		}
+		if !pair.Delete {
+			change.New = pair.Value
+		}
+
+		byStore[pair.StoreKey] = append(byStore[pair.StoreKey], change)
+	}
+
+	changes := make([]StoreChanges, 0, len(byStore))
+	for storeKey, kvs := range byStore {
+		changes = append(changes, StoreChanges{StoreKey: storeKey, Changes: kvs})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].StoreKey < changes[j].StoreKey })
+
+	return changes
+}
+
+// appFromGenesis creates an app in an in-memory database, initialized with the
+// state of the genesis file and committed.
+func appFromGenesis(appCreator servertypes.AppCreator, logger log.Logger, vp *viper.Viper, genFile, home string) (app servertypes.Application, err error) {
+	// InitChain panics on invalid genesis states.
+	defer func() {
+		if r := recover(); r != nil {
+			app, err = nil, fmt.Errorf("failed to initialize the state from %s: %v", genFile, r)
+		}
+	}()
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+
+	genDoc, err := appGenesis.ToGenesisDoc()
+	if err != nil {
+		return nil, err
+	}
+
+	if vp.GetString(flags.FlagChainID) == "" {
+		vp.Set(flags.FlagChainID, appGenesis.ChainID)
+	}
+	// the app data, e.g. snapshots, lives in a temporary home.
+	vp.Set(flags.FlagHome, home)
+	vp.Set(server.FlagAppDBBackend, string(dbm.MemDBBackend))
+
+	app = appCreator(logger, dbm.NewMemDB(), nil, vp)
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	app.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	app.Commit()
+
+	return app, nil
+}
+
+// readTx reads a JSON encoded tx from file and returns its binary encoding.
+func readTx(txConfig client.TxConfig, file string) ([]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := txConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	return txConfig.TxEncoder()(tx)
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
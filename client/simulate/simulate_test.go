@@ -0,0 +1,57 @@
+package simulate
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateChanges(t *testing.T) {
+	keyA := storetypes.NewKVStoreKey("a")
+	keyB := storetypes.NewKVStoreKey("b")
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cms.GetKVStore(keyA).Set([]byte("updated"), []byte("old"))
+	cms.GetKVStore(keyA).Set([]byte("deleted"), []byte("value"))
+	cms.GetKVStore(keyB).Set([]byte("untouched"), []byte("value"))
+	cms.Commit()
+
+	keys := cms.StoreKeysByName()
+	base, err := cms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	orig, err := cms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+
+	listener := storetypes.NewMemoryListener()
+	branch := listeningBranch(base, keys, listener)
+	branch.GetKVStore(keyA).Set([]byte("updated"), []byte("new"))
+	branch.GetKVStore(keyA).Delete([]byte("deleted"))
+	branch.GetKVStore(keyB).Set([]byte("created"), []byte("value"))
+
+	// nothing is recorded until the branch is written
+	require.Empty(t, listener.PopStateCache())
+	branch.Write()
+
+	changes := stateChanges(orig, keys, listener.PopStateCache())
+	require.Equal(t, []StoreChanges{
+		{StoreKey: "a", Changes: []KVChange{
+			{Key: []byte("deleted"), Old: []byte("value"), Deleted: true},
+			{Key: []byte("updated"), Old: []byte("old"), New: []byte("new")},
+		}},
+		{StoreKey: "b", Changes: []KVChange{
+			{Key: []byte("created"), New: []byte("value")},
+		}},
+	}, changes)
+
+	// the committed state is left untouched
+	require.Equal(t, []byte("old"), cms.GetKVStore(keyA).Get([]byte("updated")))
+}
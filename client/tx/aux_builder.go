@@ -52,6 +52,14 @@ func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
 	b.auxSignerData.SignDoc.BodyBytes = nil
 }
 
+// SetUnordered sets whether the tx is unordered.
+func (b *AuxTxBuilder) SetUnordered(unordered bool) {
+	b.checkEmptyFields()
+
+	b.body.Unordered = unordered
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
 // SetMsgs sets an array of Msgs in the tx.
 func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
 	anys := make([]*codectypes.Any, len(msgs))
@@ -190,7 +198,12 @@ func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
 		}
 	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 		{
-			signBz = legacytx.StdSignBytes(
+			stdSignBytes := legacytx.StdSignBytes
+			if b.body.Unordered {
+				stdSignBytes = legacytx.UnorderedStdSignBytes
+			}
+
+			signBz = stdSignBytes(
 				b.auxSignerData.SignDoc.ChainId, b.auxSignerData.SignDoc.AccountNumber,
 				b.auxSignerData.SignDoc.Sequence, b.body.TimeoutHeight,
 				// Aux signer never signs over fee.
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -43,6 +44,17 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningAsync makes the pruned heights be removed from disk by a
+	// background worker instead of during Commit, at most PruningAsyncRate
+	// heights per second (0 meaning no limit).
+	PruningAsync     bool   `mapstructure:"pruning-async"`
+	PruningAsyncRate uint64 `mapstructure:"pruning-async-rate"`
+
+	// StateStorage records the state in a versioned key-value store, separate
+	// from the IAVL state commitment, which serves the reads and the historical
+	// queries.
+	StateStorage bool `mapstructure:"state-storage"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -168,6 +180,24 @@ type MempoolConfig struct {
 	MaxTxs int
 }
 
+// QueryConfig defines the limits of the gRPC queries, served by the gRPC server
+// or through ABCI Query.
+type QueryConfig struct {
+	// GasLimit defines the maximum gas a query may consume in the stores. 0
+	// means no limit.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+
+	// Timeout defines the maximum wall-clock duration of a query. 0 means no
+	// limit. A query is aborted when it accesses the stores past its timeout,
+	// a query spending its time elsewhere runs to completion before its
+	// result is discarded.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// MethodLimits override the limits of gRPC query methods, in the format
+	// "<method>:<gas limit>:<timeout>".
+	MethodLimits []string `mapstructure:"method-limits"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -194,6 +224,7 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Query     QueryConfig      `mapstructure:"query"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -273,6 +304,11 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		Query: QueryConfig{
+			GasLimit:     0,
+			Timeout:      0,
+			MethodLimits: []string{},
+		},
 	}
 }
 
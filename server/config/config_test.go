@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -138,6 +139,34 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestQueryConfigWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Query = QueryConfig{
+		GasLimit:     3_000_000,
+		Timeout:      5 * time.Second,
+		MethodLimits: []string{"/cosmos.bank.v1beta1.Query/AllBalances:1000000:2s"},
+	}
+
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, conf.Query, cfg.Query)
+
+	// the default config has no limits
+	WriteConfigFile(confFile, DefaultConfig())
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err = ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, QueryConfig{MethodLimits: []string{}}, cfg.Query)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -31,6 +31,21 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningAsync removes the pruned heights from disk on a background worker
+# instead of during Commit, so that pruning does not delay the blocks. The heights
+# waiting to be pruned are persisted and pruned after a restart.
+pruning-async = {{ .BaseConfig.PruningAsync }}
+
+# PruningAsyncRate is the maximum number of heights pruned per second by the
+# background worker, 0 for no limit.
+pruning-async-rate = {{ .BaseConfig.PruningAsyncRate }}
+
+# StateStorage records the state in a versioned key-value store (data/state_storage.db),
+# separate from the IAVL state commitment, which serves the reads and the historical
+# queries. The IAVL stores can then be pruned while the full history is kept. When
+# enabled on an existing node, the state at the latest height is imported on start.
+state-storage = {{ .BaseConfig.StateStorage }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -236,6 +251,27 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+###############################################################################
+###                         Query Configuration                             ###
+###############################################################################
+
+[query]
+
+# gas-limit defines the maximum gas a gRPC query, served by the gRPC server or
+# through ABCI Query, may consume in the stores. 0 means no limit.
+gas-limit = {{ .Query.GasLimit }}
+
+# timeout defines the maximum wall-clock duration of a gRPC query, e.g. "5s".
+# 0 means no limit. A query is aborted when it accesses the stores past its
+# timeout, a query spending its time elsewhere runs to completion before its
+# result is discarded.
+timeout = "{{ .Query.Timeout }}"
+
+# method-limits override the gas limit and the timeout of gRPC query methods,
+# in the format "<method>:<gas limit>:<timeout>", a 0 meaning no limit, e.g.
+# ["/cosmos.bank.v1beta1.Query/AllBalances:1000000:2s"].
+method-limits = [{{ range .Query.MethodLimits }}{{ printf "%q, " . }}{{end}}]
 `
 
 var configTemplate *template.Template
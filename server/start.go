@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"runtime/pprof"
 
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -53,11 +55,15 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningAsync        = "pruning-async"
+	FlagPruningAsyncRate    = "pruning-async-rate"
+	FlagStateStorage        = "state-storage"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagAppDBBackend        = "app-db-backend"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -81,6 +87,11 @@ const (
 
 	// mempool flags
 	FlagMempoolMaxTxs = "mempool.max-txs"
+
+	// query flags
+	FlagQueryGasLimit     = "query.gas-limit"
+	FlagQueryTimeout      = "query.timeout"
+	FlagQueryMethodLimits = "query.method-limits"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -165,6 +176,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagPruningAsync, false, "Prune the heights on a background worker instead of during Commit")
+	cmd.Flags().Uint64(FlagPruningAsyncRate, 0, "Maximum number of heights pruned per second by the background worker, 0 for no limit (ignored if pruning-async is not set)")
+	cmd.Flags().Bool(FlagStateStorage, false, "Record the state in a versioned key-value store serving the reads and the historical queries, separate from the IAVL state commitment")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -183,6 +197,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "The maximum gas a gRPC query may consume, 0 for no limit")
+	cmd.Flags().Duration(FlagQueryTimeout, 0, "The maximum duration of a gRPC query, 0 for no limit")
+	cmd.Flags().StringSlice(FlagQueryMethodLimits, []string{}, "The limits of gRPC query methods overriding the default ones, in the format <method>:<gas limit>:<timeout>")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -215,6 +232,7 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 	}
 
 	app := appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
+	defer closeApp(svrCtx.Logger, app)
 
 	config, err := serverconfig.GetConfig(svrCtx.Viper)
 	if err != nil {
@@ -291,6 +309,8 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 	}
 
 	app := appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
+	// the application is closed after CometBFT and the servers are stopped.
+	defer closeApp(svrCtx.Logger, app)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
@@ -462,6 +482,19 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 	return g.Wait()
 }
 
+// closeApp stops the background work of the application, if it implements
+// io.Closer.
+func closeApp(logger log.Logger, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		logger.Error("failed to close the application", "err", err)
+	}
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -386,7 +386,7 @@ func ListenForQuitSignals(cancelFn context.CancelFunc, logger log.Logger) {
 
 // GetAppDBBackend gets the backend type to use for the application DBs.
 func GetAppDBBackend(opts types.AppOptions) dbm.BackendType {
-	rv := cast.ToString(opts.Get("app-db-backend"))
+	rv := cast.ToString(opts.Get(FlagAppDBBackend))
 	if len(rv) == 0 {
 		rv = cast.ToString(opts.Get("db-backend"))
 	}
@@ -487,7 +487,17 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
-	return []func(*baseapp.BaseApp){
+	queryMethodLimits, err := baseapp.ParseQueryMethodLimits(cast.ToStringSlice(appOpts.Get(FlagQueryMethodLimits)))
+	if err != nil {
+		panic(err)
+	}
+
+	queryLimits := baseapp.QueryLimits{
+		GasLimit: cast.ToUint64(appOpts.Get(FlagQueryGasLimit)),
+		Timeout:  cast.ToDuration(appOpts.Get(FlagQueryTimeout)),
+	}
+
+	opts := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -505,6 +515,22 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 			),
 		),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetQueryLimits(queryLimits, queryMethodLimits),
 		baseapp.SetChainID(chainID),
 	}
+
+	if cast.ToBool(appOpts.Get(FlagStateStorage)) {
+		ssDB, err := dbm.NewDB("state_storage", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+		if err != nil {
+			panic(err)
+		}
+
+		opts = append(opts, baseapp.SetStateStorage(ssDB))
+	}
+
+	if cast.ToBool(appOpts.Get(FlagPruningAsync)) {
+		opts = append(opts, baseapp.SetAsyncPruning(cast.ToUint64(appOpts.Get(FlagPruningAsyncRate))))
+	}
+
+	return opts
 }
@@ -3,12 +3,14 @@ package simapp
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/upgrade"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
@@ -16,25 +18,31 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -196,6 +204,8 @@ func TestRunMigrations(t *testing.T) {
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
 					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+					"ratelimit":    ratelimit.AppModule{}.ConsensusVersion(),
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
@@ -273,6 +283,51 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
 
+// TestRefundUnusedFees tests that simapp refunds the fee paying for the gas a
+// tx did not use.
+func TestRefundUnusedFees(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	initCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+	acc := authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, banktypes.Balance{Address: addr.String(), Coins: initCoins})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	sent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sent)
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		app.TxConfig(),
+		[]sdk.Msg{msg},
+		fee,
+		simtestutil.DefaultGenTxGas,
+		"",
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{0},
+		priv,
+	)
+	require.NoError(t, err)
+
+	gasInfo, _, err := app.SimDeliver(app.TxConfig().TxEncoder(), tx)
+	require.NoError(t, err)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// only the share of the fee paying for the gas used is charged
+	ctx := app.NewContext(true, cmtproto.Header{})
+	charged := initCoins.Sub(sent...).Sub(app.BankKeeper.GetAllBalances(ctx, addr)...)
+	require.True(t, charged.IsAllPositive())
+	require.True(t, charged.IsAllLT(fee))
+	// the gas used reported includes the refund itself
+	maxCharged := fee.Sub(posthandler.RefundedFee(fee, gasInfo.GasUsed, gasInfo.GasWanted, sdkmath.LegacyZeroDec())...)
+	require.True(t, charged.IsAllLTE(maxCharged))
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
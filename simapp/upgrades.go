@@ -12,10 +12,12 @@ import (
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ratelimittypes "github.com/cosmos/cosmos-sdk/x/ratelimit/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -28,6 +30,10 @@ import (
 // v0.46.x to v0.47.x.
 const UpgradeName = "v046-to-v047"
 
+// V048UpgradeName defines the on-chain upgrade name for the sample SimApp
+// upgrade from v047 to v048, adding the stores of the modules added since v047.
+const V048UpgradeName = "v047-to-v048"
+
 func (app SimApp) RegisterUpgradeHandlers() {
 	// Set param key table for params module migration
 	for _, subspace := range app.ParamsKeeper.GetSubspaces() {
@@ -73,20 +79,43 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		V048UpgradeName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// The added modules are missing from fromVM, so their genesis is initialized.
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	var storeUpgrades storetypes.StoreUpgrades
+	switch upgradeInfo.Name {
+	case UpgradeName:
+		storeUpgrades = storetypes.StoreUpgrades{
 			Added: []string{
 				consensustypes.ModuleName,
 				crisistypes.ModuleName,
 			},
 		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	case V048UpgradeName:
+		storeUpgrades = storetypes.StoreUpgrades{
+			Added: []string{
+				feemarkettypes.StoreKey,
+				ratelimittypes.StoreKey,
+			},
+		}
+	default:
+		return
 	}
+
+	// configure store loader that checks if version == upgradeHeight and applies store upgrades
+	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 }
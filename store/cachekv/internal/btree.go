@@ -21,8 +21,15 @@ var errKeyEmpty = errors.New("key cannot be empty")
 // we need it to be as fast as possible, while `MemDB` is mainly used as a mocking db in unit tests.
 //
 // We choose tidwall/btree over google/btree here because it provides API to implement step iterator directly.
+//
+// The trees are not safe for concurrent use, the cachekv store guards them with
+// its mutex.
 type BTree struct {
 	tree *btree.BTreeG[item]
+	// seq numbers the writes of the tree, so that the writes made to a copy
+	// of a tree are told apart from the items of the tree. A copy carries on
+	// the numbering of its tree.
+	seq *uint64
 }
 
 // NewBTree creates a wrapper around `btree.BTreeG`.
@@ -30,13 +37,18 @@ func NewBTree() BTree {
 	return BTree{
 		tree: btree.NewBTreeGOptions(byKeys, btree.Options{
 			Degree:  bTreeDegree,
-			NoLocks: false,
+			NoLocks: true,
 		}),
+		seq: new(uint64),
 	}
 }
 
+// Set sets the value of a key. Every call is a distinct write, see Diff.
 func (bt BTree) Set(key, value []byte) {
-	bt.tree.Set(newItem(key, value))
+	i := newItem(key, value)
+	*bt.seq++
+	i.seq = *bt.seq
+	bt.tree.Set(i)
 }
 
 func (bt BTree) Get(key []byte) []byte {
@@ -47,6 +59,37 @@ func (bt BTree) Get(key []byte) []byte {
 	return i.value
 }
 
+// Lookup returns the value of a key and whether the key is in the tree, as a
+// nil value marks a deleted key.
+func (bt BTree) Lookup(key []byte) ([]byte, bool) {
+	i, found := bt.tree.Get(newItem(key, nil))
+	return i.value, found
+}
+
+// Len returns the number of items in the tree.
+func (bt BTree) Len() int {
+	return bt.tree.Len()
+}
+
+// Scan calls fn on the items of the tree in ascending order of keys, until it
+// returns false.
+func (bt BTree) Scan(fn func(key, value []byte) bool) {
+	bt.tree.Scan(func(i item) bool {
+		return fn(i.key, i.value)
+	})
+}
+
+// Diff calls fn in ascending order of keys on the items set in the tree since
+// it was copied from base, even when they set the value already in base.
+func (bt BTree) Diff(base BTree, fn func(key, value []byte)) {
+	bt.tree.Scan(func(i item) bool {
+		if baseItem, found := base.tree.Get(i); !found || baseItem.seq != i.seq {
+			fn(i.key, i.value)
+		}
+		return true
+	})
+}
+
 func (bt BTree) Delete(key []byte) {
 	bt.tree.Delete(newItem(key, nil))
 }
@@ -68,8 +111,10 @@ func (bt BTree) ReverseIterator(start, end []byte) (types.Iterator, error) {
 // Copy the tree. This is a copy-on-write operation and is very fast because
 // it only performs a shadowed copy.
 func (bt BTree) Copy() BTree {
+	seq := *bt.seq
 	return BTree{
 		tree: bt.tree.Copy(),
+		seq:  &seq,
 	}
 }
 
@@ -77,6 +122,7 @@ func (bt BTree) Copy() BTree {
 type item struct {
 	key   []byte
 	value []byte
+	seq   uint64
 }
 
 // byKeys compares the items by key
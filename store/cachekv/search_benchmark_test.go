@@ -1,10 +1,13 @@
-package cachekv
+package cachekv_test
 
 import (
 	"strconv"
 	"testing"
 
-	"cosmossdk.io/store/cachekv/internal"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
 )
 
 func BenchmarkLargeUnsortedMisses(b *testing.B) {
@@ -16,29 +19,21 @@ func BenchmarkLargeUnsortedMisses(b *testing.B) {
 		for k := 0; k < 10000; k++ {
 			// cache has A + Z values
 			// these are within range, but match nothing
-			store.dirtyItems([]byte("B1"), []byte("B2"))
+			iter := store.Iterator([]byte("B1"), []byte("B2"))
+			iter.Close()
 		}
 	}
 }
 
-func generateStore() *Store {
-	cache := map[string]*cValue{}
-	unsorted := map[string]struct{}{}
+func generateStore() *cachekv.Store {
+	store := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
 	for i := 0; i < 5000; i++ {
-		key := "A" + strconv.Itoa(i)
-		unsorted[key] = struct{}{}
-		cache[key] = &cValue{}
+		store.Set([]byte("A"+strconv.Itoa(i)), []byte{})
 	}
 
 	for i := 0; i < 5000; i++ {
-		key := "Z" + strconv.Itoa(i)
-		unsorted[key] = struct{}{}
-		cache[key] = &cValue{}
+		store.Set([]byte("Z"+strconv.Itoa(i)), []byte{})
 	}
 
-	return &Store{
-		cache:         cache,
-		unsortedCache: unsorted,
-		sortedCache:   internal.NewBTree(),
-	}
+	return store
 }
@@ -1,141 +1,134 @@
-package cachekv
+package cachekv_test
 
-import "testing"
+import (
+	"testing"
 
-func TestFindStartIndex(t *testing.T) {
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+func TestIteratorStart(t *testing.T) {
 	tests := []struct {
-		name    string
-		sortedL []string
-		query   string
-		want    int
+		name  string
+		keys  []string
+		query string
+		want  []string
 	}{
 		{
-			name:    "non-existent value",
-			sortedL: []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "o",
-			want:    8,
-		},
-		{
-			name:    "dupes start at index 0",
-			sortedL: []string{"a", "a", "a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "a",
-			want:    0,
-		},
-		{
-			name:    "dupes start at non-index 0",
-			sortedL: []string{"a", "c", "c", "c", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "c",
-			want:    1,
+			name:  "non-existent value",
+			keys:  []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
+			query: "o",
+			want:  []string{"u", "v", "w", "x", "y", "z"},
 		},
 		{
-			name:    "at end",
-			sortedL: []string{"a", "e", "u", "v", "w", "x", "y", "z"},
-			query:   "z",
-			want:    7,
+			name:  "at start",
+			keys:  []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
+			query: "a",
+			want:  []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
 		},
 		{
-			name:    "dupes at end",
-			sortedL: []string{"a", "e", "u", "v", "w", "x", "y", "z", "z", "z", "z"},
-			query:   "z",
-			want:    7,
+			name:  "at end",
+			keys:  []string{"a", "e", "u", "v", "w", "x", "y", "z"},
+			query: "z",
+			want:  []string{"z"},
 		},
 		{
-			name:    "entirely dupes",
-			sortedL: []string{"z", "z", "z", "z", "z"},
-			query:   "z",
-			want:    0,
+			name:  "non-existent but within >=start",
+			keys:  []string{"z"},
+			query: "p",
+			want:  []string{"z"},
 		},
 		{
-			name:    "non-existent but within >=start",
-			sortedL: []string{"z", "z", "z", "z", "z"},
-			query:   "p",
-			want:    0,
-		},
-		{
-			name:    "non-existent and out of range",
-			sortedL: []string{"d", "e", "f", "g", "h"},
-			query:   "z",
-			want:    -1,
+			name:  "non-existent and out of range",
+			keys:  []string{"d", "e", "f", "g", "h"},
+			query: "z",
+			want:  nil,
 		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			body := tt.sortedL
-			got := findStartIndex(body, tt.query)
-			if got != tt.want {
-				t.Fatalf("Got: %d, want: %d", got, tt.want)
-			}
+			store, branch := newSearchStores(tt.keys)
+			require.Equal(t, tt.want, collectKeys(store.Iterator([]byte(tt.query), nil)))
+			require.Equal(t, tt.want, collectKeys(branch.Iterator([]byte(tt.query), nil)))
 		})
 	}
 }
 
-func TestFindEndIndex(t *testing.T) {
+func TestIteratorEnd(t *testing.T) {
 	tests := []struct {
-		name    string
-		sortedL []string
-		query   string
-		want    int
+		name  string
+		keys  []string
+		query string
+		want  []string
 	}{
 		{
-			name:    "non-existent value",
-			sortedL: []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "o",
-			want:    7,
-		},
-		{
-			name:    "dupes start at index 0",
-			sortedL: []string{"a", "a", "a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "a",
-			want:    0,
-		},
-		{
-			name:    "dupes start at non-index 0",
-			sortedL: []string{"a", "c", "c", "c", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
-			query:   "c",
-			want:    1,
+			name:  "non-existent value",
+			keys:  []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
+			query: "o",
+			want:  []string{"a", "b", "c", "d", "e", "l", "m", "n"},
 		},
 		{
-			name:    "at end",
-			sortedL: []string{"a", "e", "u", "v", "w", "x", "y", "z"},
-			query:   "z",
-			want:    7,
+			name:  "at start",
+			keys:  []string{"a", "b", "c", "d", "e", "l", "m", "n", "u", "v", "w", "x", "y", "z"},
+			query: "a",
+			want:  nil,
 		},
 		{
-			name:    "dupes at end",
-			sortedL: []string{"a", "e", "u", "v", "w", "x", "y", "z", "z", "z", "z"},
-			query:   "z",
-			want:    7,
+			name:  "at end",
+			keys:  []string{"a", "e", "u", "v", "w", "x", "y", "z"},
+			query: "z",
+			want:  []string{"a", "e", "u", "v", "w", "x", "y"},
 		},
 		{
-			name:    "entirely dupes",
-			sortedL: []string{"z", "z", "z", "z", "z"},
-			query:   "z",
-			want:    0,
+			name:  "non-existent and out of range",
+			keys:  []string{"z"},
+			query: "p",
+			want:  nil,
 		},
 		{
-			name:    "non-existent and out of range",
-			sortedL: []string{"z", "z", "z", "z", "z"},
-			query:   "p",
-			want:    -1,
-		},
-		{
-			name:    "non-existent and out of range",
-			sortedL: []string{"d", "e", "f", "g", "h"},
-			query:   "z",
-			want:    4,
+			name:  "non-existent after the last value",
+			keys:  []string{"d", "e", "f", "g", "h"},
+			query: "z",
+			want:  []string{"d", "e", "f", "g", "h"},
 		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			body := tt.sortedL
-			got := findEndIndex(body, tt.query)
-			if got != tt.want {
-				t.Fatalf("Got: %d, want: %d", got, tt.want)
-			}
+			store, branch := newSearchStores(tt.keys)
+			require.Equal(t, tt.want, collectKeys(store.Iterator(nil, []byte(tt.query))))
+			require.Equal(t, tt.want, collectKeys(branch.Iterator(nil, []byte(tt.query))))
 		})
 	}
 }
+
+// newSearchStores returns a store holding keys as writes, and a branch of an
+// empty store holding keys as the writes of its parent.
+func newSearchStores(keys []string) (*cachekv.Store, *cachekv.Store) {
+	store := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	for _, key := range keys {
+		store.Set([]byte(key), []byte(key))
+		parent.Set([]byte(key), []byte(key))
+	}
+
+	return store, cachekv.NewStore(parent)
+}
+
+func collectKeys(iter types.Iterator) []string {
+	defer iter.Close()
+
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+
+	return keys
+}
@@ -1,52 +1,93 @@
 package cachekv
 
 import (
-	"bytes"
 	"io"
-	"sort"
 	"sync"
 
-	"cosmossdk.io/math"
-	dbm "github.com/cosmos/cosmos-db"
-
 	"cosmossdk.io/store/cachekv/internal"
 	"cosmossdk.io/store/internal/conv"
-	"cosmossdk.io/store/internal/kv"
+	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/types"
 )
 
-// cValue represents a cached value.
-// If dirty is true, it indicates the cached value is different from the underlying value.
-type cValue struct {
-	value []byte
-	dirty bool
-}
-
 // Store wraps an in-memory cache around an underlying types.KVStore.
+//
+// The writes are held in a persistent B-tree, always sorted, where a nil value
+// marks a deleted key. A branch of a Store starts from a copy-on-write copy of
+// the tree of its parent, so branching is O(1), a branch holds every write of
+// its ancestors, and its reads and iterators go straight to the store under the
+// first Store of the stack. Writing a branch back to an unchanged parent hands
+// over its tree in O(1).
+//
+// When a parent is written to while it has branches, the branches are rebased
+// on the new tree of the parent on their next read, by replaying their writes.
+// Branches are usually written back or discarded before their parent is used
+// again, so this is rare.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   internal.BTree // always ascending sorted
-	parent        types.KVStore
+	mtx sync.Mutex
+
+	// writes holds the writes of the store and of its ancestors, up to the
+	// first Store of the stack.
+	writes internal.BTree
+	// gen is incremented whenever writes is changed, so that the branches
+	// know they must be rebased.
+	gen uint64
+
+	// parent is the parent Store of a branch, nil for the first Store of the
+	// stack. base is the copy of the writes of the parent the branch started
+	// from, at generation baseGen of the parent.
+	parent  *Store
+	base    internal.BTree
+	baseGen uint64
+
+	// root is the first Store of the stack, which reads from the underlying
+	// store and caches the values read in cache.
+	root       *Store
+	underlying types.KVStore
+	cache      map[string][]byte
+
+	// storeKey and metrics are set when the parent reports metrics.
+	storeKey string
+	metrics  metrics.StoreMetrics
 }
 
-var _ types.CacheKVStore = (*Store)(nil)
+var (
+	_ types.CacheKVStore = (*Store)(nil)
+	_ metrics.Reporter   = (*Store)(nil)
+)
 
-// NewStore creates a new Store object
+// NewStore creates a new Store object. If the parent reports metrics, the
+// cache hits and misses are reported under the same store key.
 func NewStore(parent types.KVStore) *Store {
-	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   internal.NewBTree(),
-		parent:        parent,
+	store := &Store{}
+
+	if p, ok := parent.(*Store); ok {
+		store.parent = p
+		store.root = p.root
+		store.writes, store.base, store.baseGen = p.branch()
+	} else {
+		store.root = store
+		store.underlying = parent
+		store.cache = make(map[string][]byte)
+		store.writes = internal.NewBTree()
+	}
+
+	if reporter, ok := parent.(metrics.Reporter); ok {
+		store.storeKey, store.metrics = reporter.StoreMetrics()
 	}
+
+	return store
+}
+
+// StoreMetrics implements metrics.Reporter.
+func (store *Store) StoreMetrics() (string, metrics.StoreMetrics) {
+	return store.storeKey, store.metrics
 }
 
 // GetStoreType implements Store.
 func (store *Store) GetStoreType() types.StoreType {
-	return store.parent.GetStoreType()
+	return store.root.underlying.GetStoreType()
 }
 
 // Get implements types.KVStore.
@@ -56,17 +97,36 @@ func (store *Store) Get(key []byte) (value []byte) {
 
 	types.AssertValidKey(key)
 
-	cacheValue, ok := store.cache[conv.UnsafeBytesToStr(key)]
-	if !ok {
-		value = store.parent.Get(key)
-		store.setCacheValue(key, value, false)
-	} else {
-		value = cacheValue.value
+	store.rebase()
+
+	value, ok := store.writes.Lookup(key)
+	if !ok && store.parent == nil {
+		value, ok = store.read(key)
+	}
+	store.reportRead(ok)
+
+	// A branch keeps no cache of the values read, they are read through the
+	// cache of the root store, which reports the read as well.
+	if !ok && store.parent != nil {
+		value = store.root.readLocked(key)
 	}
 
 	return value
 }
 
+// reportRead reports a cache hit or miss if the store reports metrics.
+func (store *Store) reportRead(hit bool) {
+	if store.metrics == nil {
+		return
+	}
+
+	if hit {
+		store.metrics.IncrStoreCounter(store.storeKey, 1, "store", "cachekv", "hit")
+	} else {
+		store.metrics.IncrStoreCounter(store.storeKey, 1, "store", "cachekv", "miss")
+	}
+}
+
 // Set implements types.KVStore.
 func (store *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
@@ -74,7 +134,9 @@ func (store *Store) Set(key []byte, value []byte) {
 
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
-	store.setCacheValue(key, value, true)
+
+	store.writes.Set(key, value)
+	store.gen++
 }
 
 // Has implements types.KVStore.
@@ -90,7 +152,9 @@ func (store *Store) Delete(key []byte) {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.setCacheValue(key, nil, true)
+	// writes is able to store `nil` value to represent deleted items.
+	store.writes.Set(key, nil)
+	store.gen++
 }
 
 // Implements Cachetypes.KVStore.
@@ -98,49 +162,116 @@ func (store *Store) Write() {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	if len(store.cache) == 0 && len(store.unsortedCache) == 0 {
-		store.sortedCache = internal.NewBTree()
+	if store.parent == nil {
+		store.writes.Scan(func(key, value []byte) bool {
+			if value != nil {
+				store.underlying.Set(key, value)
+			} else {
+				store.underlying.Delete(key)
+			}
+			return true
+		})
+
+		// Clear the cache using the map clearing idiom
+		// and not allocating fresh objects.
+		// Please see https://bencher.orijtech.com/perfclinic/mapclearing/
+		for key := range store.cache {
+			delete(store.cache, key)
+		}
+		store.writes = internal.NewBTree()
+		store.gen++
 		return
 	}
 
-	// We need a copy of all of the keys.
-	// Not the best, but probably not a bottleneck depending.
-	keys := make([]string, 0, len(store.cache))
+	store.writes, store.base, store.baseGen = store.parent.merge(store.writes, store.base, store.baseGen)
+}
 
-	for key, dbValue := range store.cache {
-		if dbValue.dirty {
-			keys = append(keys, key)
-		}
+// branch returns two copies of the writes of the store, up to date with its
+// ancestors, and their generation, to start a branch from.
+func (store *Store) branch() (writes, base internal.BTree, gen uint64) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	store.rebase()
+	return store.writes.Copy(), store.writes.Copy(), store.gen
+}
+
+// generation returns the generation of the writes of the store, up to date
+// with its ancestors.
+func (store *Store) generation() uint64 {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	store.rebase()
+	return store.gen
+}
+
+// merge writes back the writes of a branch started from base at generation
+// gen, and returns the copies of the writes to restart the branch from. The
+// writes of the branch are handed over when the store hasn't changed since
+// then, and replayed otherwise.
+func (store *Store) merge(writes, base internal.BTree, gen uint64) (internal.BTree, internal.BTree, uint64) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	if gen == store.gen {
+		store.writes = writes
+	} else {
+		replay(store.writes, writes, base)
 	}
+	store.gen++
 
-	sort.Strings(keys)
-
-	// TODO: Consider allowing usage of Batch, which would allow the write to
-	// at least happen atomically.
-	for _, key := range keys {
-		// We use []byte(key) instead of conv.UnsafeStrToBytes because we cannot
-		// be sure if the underlying store might do a save with the byteslice or
-		// not. Once we get confirmation that .Delete is guaranteed not to
-		// save the byteslice, then we can assume only a read-only copy is sufficient.
-		cacheValue := store.cache[key]
-		if cacheValue.value != nil {
-			// It already exists in the parent, hence update it.
-			store.parent.Set([]byte(key), cacheValue.value)
-		} else {
-			store.parent.Delete([]byte(key))
-		}
+	return store.writes.Copy(), store.writes.Copy(), store.gen
+}
+
+// rebase rebases the writes of a branch on the writes of its parent if they
+// have changed since it started from them. The branches of the store are then
+// rebased too.
+func (store *Store) rebase() {
+	if store.parent == nil {
+		return
 	}
 
-	// Clear the cache using the map clearing idiom
-	// and not allocating fresh objects.
-	// Please see https://bencher.orijtech.com/perfclinic/mapclearing/
-	for key := range store.cache {
-		delete(store.cache, key)
+	if store.parent.generation() == store.baseGen {
+		return
 	}
-	for key := range store.unsortedCache {
-		delete(store.unsortedCache, key)
+
+	writes, base, gen := store.parent.branch()
+
+	replay(writes, store.writes, store.base)
+	store.writes, store.base, store.baseGen = writes, base, gen
+	store.gen++
+}
+
+// replay sets in dst the writes made to src since it was copied from base.
+func replay(dst, src, base internal.BTree) {
+	src.Diff(base, func(key, value []byte) {
+		dst.Set(key, value)
+	})
+}
+
+// read reads a key missing from the writes of a stack from the underlying
+// store, through the cache of the root store. It returns whether the value was
+// cached.
+func (store *Store) read(key []byte) ([]byte, bool) {
+	value, ok := store.cache[conv.UnsafeBytesToStr(key)]
+	if !ok {
+		value = store.underlying.Get(key)
+		store.cache[string(key)] = value
 	}
-	store.sortedCache = internal.NewBTree()
+
+	return value, ok
+}
+
+// readLocked is read for the branches of the root store.
+func (store *Store) readLocked(key []byte) []byte {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	value, ok := store.read(key)
+	store.reportRead(ok)
+
+	return value
 }
 
 // CacheWrap implements CacheWrapper.
@@ -170,8 +301,8 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.dirtyItems(start, end)
-	isoSortedCache := store.sortedCache.Copy()
+	store.rebase()
+	isoWrites := store.writes.Copy()
 
 	var (
 		err           error
@@ -179,11 +310,11 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	)
 
 	if ascending {
-		parent = store.parent.Iterator(start, end)
-		cache, err = isoSortedCache.Iterator(start, end)
+		parent = store.root.underlying.Iterator(start, end)
+		cache, err = isoWrites.Iterator(start, end)
 	} else {
-		parent = store.parent.ReverseIterator(start, end)
-		cache, err = isoSortedCache.ReverseIterator(start, end)
+		parent = store.root.underlying.ReverseIterator(start, end)
+		cache, err = isoWrites.ReverseIterator(start, end)
 	}
 	if err != nil {
 		panic(err)
@@ -191,200 +322,3 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	return internal.NewCacheMergeIterator(parent, cache, ascending)
 }
-
-func findStartIndex(strL []string, startQ string) int {
-	// Modified binary search to find the very first element in >=startQ.
-	if len(strL) == 0 {
-		return -1
-	}
-
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == startQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] != midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < startQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
-		}
-	}
-	if left >= 0 && left < len(strL) && strL[left] >= startQ {
-		return left
-	}
-	return -1
-}
-
-func findEndIndex(strL []string, endQ string) int {
-	if len(strL) == 0 {
-		return -1
-	}
-
-	// Modified binary search to find the very first element <endQ.
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == endQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] < midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < endQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
-		}
-	}
-
-	// Binary search failed, now let's find a value less than endQ.
-	for i := right; i >= 0; i-- {
-		if strL[i] < endQ {
-			return i
-		}
-	}
-
-	return -1
-}
-
-type sortState int
-
-const (
-	stateUnsorted sortState = iota
-	stateAlreadySorted
-)
-
-const minSortSize = 1024
-
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	startStr, endStr := conv.UnsafeBytesToStr(start), conv.UnsafeBytesToStr(end)
-	if end != nil && startStr > endStr {
-		// Nothing to do here.
-		return
-	}
-
-	n := len(store.unsortedCache)
-	unsorted := make([]*kv.Pair, 0)
-	// If the unsortedCache is too big, its costs too much to determine
-	// whats in the subset we are concerned about.
-	// If you are interleaving iterator calls with writes, this can easily become an
-	// O(N^2) overhead.
-	// Even without that, too many range checks eventually becomes more expensive
-	// than just not having the cache.
-	if n < minSortSize {
-		for key := range store.unsortedCache {
-			// dbm.IsKeyInDomain is nil safe and returns true iff key is greater than start
-			if dbm.IsKeyInDomain(conv.UnsafeStrToBytes(key), start, end) {
-				cacheValue := store.cache[key]
-				unsorted = append(unsorted, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
-			}
-		}
-		store.clearUnsortedCacheSubset(unsorted, stateUnsorted)
-		return
-	}
-
-	// Otherwise it is large so perform a modified binary search to find
-	// the target ranges for the keys that we should be looking for.
-	strL := make([]string, 0, n)
-	for key := range store.unsortedCache {
-		strL = append(strL, key)
-	}
-	sort.Strings(strL)
-
-	// Now find the values within the domain
-	//  [start, end)
-	startIndex := findStartIndex(strL, startStr)
-	if startIndex < 0 {
-		startIndex = 0
-	}
-
-	var endIndex int
-	if end == nil {
-		endIndex = len(strL) - 1
-	} else {
-		endIndex = findEndIndex(strL, endStr)
-	}
-	if endIndex < 0 {
-		endIndex = len(strL) - 1
-	}
-
-	// Since we spent cycles to sort the values, we should process and remove a reasonable amount
-	// ensure start to end is at least minSortSize in size
-	// if below minSortSize, expand it to cover additional values
-	// this amortizes the cost of processing elements across multiple calls
-	if endIndex-startIndex < minSortSize {
-		endIndex = math.Min(startIndex+minSortSize, len(strL)-1)
-		if endIndex-startIndex < minSortSize {
-			startIndex = math.Max(endIndex-minSortSize, 0)
-		}
-	}
-
-	kvL := make([]*kv.Pair, 0, 1+endIndex-startIndex)
-	for i := startIndex; i <= endIndex; i++ {
-		key := strL[i]
-		cacheValue := store.cache[key]
-		kvL = append(kvL, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
-	}
-
-	// kvL was already sorted so pass it in as is.
-	store.clearUnsortedCacheSubset(kvL, stateAlreadySorted)
-}
-
-func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sortState) {
-	n := len(store.unsortedCache)
-	if len(unsorted) == n { // This pattern allows the Go compiler to emit the map clearing idiom for the entire map.
-		for key := range store.unsortedCache {
-			delete(store.unsortedCache, key)
-		}
-	} else { // Otherwise, normally delete the unsorted keys from the map.
-		for _, kv := range unsorted {
-			delete(store.unsortedCache, conv.UnsafeBytesToStr(kv.Key))
-		}
-	}
-
-	if sortState == stateUnsorted {
-		sort.Slice(unsorted, func(i, j int) bool {
-			return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-		})
-	}
-
-	for _, item := range unsorted {
-		// sortedCache is able to store `nil` value to represent deleted items.
-		store.sortedCache.Set(item.Key, item.Value)
-	}
-}
-
-//----------------------------------------
-// etc
-
-// Only entrypoint to mutate store.cache.
-// A `nil` value means a deletion.
-func (store *Store) setCacheValue(key, value []byte, dirty bool) {
-	keyStr := conv.UnsafeBytesToStr(key)
-	store.cache[keyStr] = &cValue{
-		value: value,
-		dirty: dirty,
-	}
-	if dirty {
-		store.unsortedCache[keyStr] = struct{}{}
-	}
-}
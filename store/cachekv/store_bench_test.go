@@ -91,6 +91,25 @@ func benchmarkRandomSet(b *testing.B, keysize int) {
 	}
 }
 
+// Benchmark setting New keys to a store, where the new keys are random, and
+// writing them to the parent store.
+func benchmarkRandomSetWrite(b *testing.B, keysize int) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	kvstore := cachekv.NewStore(mem)
+
+	// Use a singleton for value, to not waste time computing it
+	value := randSlice(defaultValueSizeBz)
+	keys := generateRandomKeys(keysize, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for _, k := range keys {
+		kvstore.Set(k, value)
+	}
+	kvstore.Write()
+}
+
 // Benchmark creating an iterator on a parent with D entries,
 // that are all deleted in the cacheKV store.
 // We essentially are benchmarking the cacheKV iterator creation & iteration times
@@ -144,6 +163,10 @@ func BenchmarkSetKeySize32(b *testing.B) {
 	benchmarkRandomSet(b, 32)
 }
 
+func BenchmarkSetWriteKeySize32(b *testing.B) {
+	benchmarkRandomSetWrite(b, 32)
+}
+
 func BenchmarkIteratorOnParentWith1MDeletes(b *testing.B) {
 	benchmarkIteratorOnParentWithManyDeletes(b, 1_000_000)
 }
@@ -431,6 +431,131 @@ func TestNilEndIterator(t *testing.T) {
 	}
 }
 
+func TestCacheKVStoreNestedParentWrites(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(0), valFmt(0))
+	st := cachekv.NewStore(mem)
+	st.Set(keyFmt(1), valFmt(1))
+
+	st2 := cachekv.NewStore(st)
+	st3 := cachekv.NewStore(st2)
+	sibling := cachekv.NewStore(st)
+	st3.Set(keyFmt(3), valFmt(3))
+
+	// the writes of an ancestor after branching are seen by the branches.
+	st.Set(keyFmt(2), valFmt(2))
+	st.Delete(keyFmt(0))
+	require.Equal(t, valFmt(2), st3.Get(keyFmt(2)))
+	require.Nil(t, st3.Get(keyFmt(0)))
+	require.Equal(t, valFmt(3), st3.Get(keyFmt(3)))
+	assertIterateDomainCompare(t, st3, dbOf(t, 1, 2, 3))
+
+	// st3 is written back to st2, which has been rebased, and st2 to st.
+	st3.Write()
+	require.Equal(t, valFmt(3), st2.Get(keyFmt(3)))
+	require.Nil(t, st.Get(keyFmt(3)))
+	st2.Set(keyFmt(4), valFmt(4))
+	st2.Write()
+	assertIterateDomainCompare(t, st, dbOf(t, 1, 2, 3, 4))
+
+	// the sibling sees the writes of st2, and its writes are replayed on st.
+	require.Equal(t, valFmt(4), sibling.Get(keyFmt(4)))
+	sibling.Delete(keyFmt(4))
+	sibling.Set(keyFmt(5), valFmt(5))
+	st.Set(keyFmt(6), valFmt(6))
+	sibling.Write()
+	assertIterateDomainCompare(t, st, dbOf(t, 1, 2, 3, 5, 6))
+
+	// branches are still usable after being written back.
+	st3.Set(keyFmt(7), valFmt(7))
+	require.Equal(t, valFmt(6), st3.Get(keyFmt(6)))
+	require.Nil(t, st3.Get(keyFmt(4)))
+	st3.Write()
+	st2.Write()
+	st.Write()
+	assertIterateDomainCompare(t, mem, dbOf(t, 1, 2, 3, 5, 6, 7))
+}
+
+// dbOf returns a database holding the keys of keyFmt and valFmt.
+func dbOf(t *testing.T, keys ...int) dbm.DB {
+	db := dbm.NewMemDB()
+	for _, k := range keys {
+		require.NoError(t, db.Set(keyFmt(k), valFmt(k)))
+	}
+	return db
+}
+
+// TestCacheKVStoreStackRandom runs random operations on every level of a stack
+// of branches, and checks every level against a model of the stack.
+func TestCacheKVStoreStackRandom(t *testing.T) {
+	const maxKey = 50
+
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	stack := []*cachekv.Store{cachekv.NewStore(mem)}
+	// writes[i] holds the writes of stack[i], a nil value marks a deletion.
+	writes := []map[int][]byte{{}}
+
+	visible := func(level, k int) []byte {
+		for i := level; i >= 0; i-- {
+			if value, ok := writes[i][k]; ok {
+				return value
+			}
+		}
+		return mem.Get(keyFmt(k))
+	}
+
+	for i := 0; i < 5000; i++ {
+		level := randInt(len(stack))
+		st := stack[level]
+		k := randInt(maxKey)
+
+		switch op := randInt(10); {
+		case op < 4:
+			value := []byte(fmt.Sprintf("value%d-%d", k, i))
+			st.Set(keyFmt(k), value)
+			writes[level][k] = value
+		case op < 6:
+			st.Delete(keyFmt(k))
+			writes[level][k] = nil
+		case op == 6 && len(stack) < 8:
+			stack = append(stack, cachekv.NewStore(stack[len(stack)-1]))
+			writes = append(writes, map[int][]byte{})
+		case op == 7 && len(stack) > 1:
+			stack, writes = stack[:len(stack)-1], writes[:len(writes)-1]
+		case op == 8:
+			// only the top of the stack is written back, as writing a level
+			// with branches drops the writes of the level from the model.
+			top := len(stack) - 1
+			stack[top].Write()
+			for key, value := range writes[top] {
+				if top == 0 {
+					if value == nil {
+						mem.Delete(keyFmt(key))
+					} else {
+						mem.Set(keyFmt(key), value)
+					}
+				} else {
+					writes[top-1][key] = value
+				}
+			}
+			writes[top] = map[int][]byte{}
+		}
+
+		level = randInt(len(stack))
+		require.Equal(t, visible(level, k), stack[level].Get(keyFmt(k)), "op %d: key %d at level %d", i, k, level)
+
+		if i%10 == 0 {
+			truth := dbm.NewMemDB()
+			for key := 0; key < maxKey; key++ {
+				if value := visible(level, key); value != nil {
+					require.NoError(t, truth.Set(keyFmt(key), value))
+				}
+			}
+			assertIterateDomainCompare(t, stack[level], truth)
+		}
+	}
+}
+
 // TestIteratorDeadlock demonstrate the deadlock issue in cache store.
 func TestIteratorDeadlock(t *testing.T) {
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
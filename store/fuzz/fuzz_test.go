@@ -0,0 +1,75 @@
+package fuzz
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateRoot(t *testing.T) {
+	for _, wrappers := range WrapperSets() {
+		for seed := int64(0); seed < 20; seed++ {
+			cfg := Config{Stores: 2, Wrappers: wrappers}
+			ops := RandomOps(rand.New(rand.NewSource(seed)), 200, 2)
+			if err := Run(cfg, ops); err != nil {
+				t.Fatalf("wrappers %v, seed %d: %v\nminimal ops: %v", wrappers, seed, err, Minimize(cfg, ops))
+			}
+		}
+	}
+}
+
+func TestRunDetectsDifferences(t *testing.T) {
+	ops := []Op{
+		{Kind: OpSet, Store: 0, Key: []byte("a"), Value: []byte{1}},
+		{Kind: OpCommit},
+	}
+	require.NoError(t, Run(Config{Stores: 1}, ops))
+	require.Error(t, Run(Config{Stores: 1}, append(ops, Op{Kind: OpSet, Store: 1, Key: []byte("a"), Value: []byte{1}})))
+}
+
+func TestMinimize(t *testing.T) {
+	ops := []Op{
+		{Kind: OpSet, Store: 0, Key: []byte("a"), Value: []byte{1}},
+		{Kind: OpCommit},
+		{Kind: OpSet, Store: 1, Key: []byte("a"), Value: []byte{1}},
+		{Kind: OpGet, Store: 0, Key: []byte("a")},
+	}
+	require.Equal(t, ops, Minimize(Config{Stores: 2}, ops))
+	require.Equal(t, ops[2:3], Minimize(Config{Stores: 1}, ops))
+}
+
+func TestDecodeOps(t *testing.T) {
+	require.Empty(t, DecodeOps(nil, 2))
+
+	data := make([]byte, 1000)
+	rand.New(rand.NewSource(0)).Read(data)
+	ops := DecodeOps(data, 2)
+	require.NotEmpty(t, ops)
+	require.Equal(t, ops, DecodeOps(data, 2))
+	for _, op := range ops {
+		require.Less(t, op.Store, 2)
+	}
+}
+
+func FuzzStateRoot(f *testing.F) {
+	for seed := int64(0); seed < 4; seed++ {
+		data := make([]byte, 256)
+		rand.New(rand.NewSource(seed)).Read(data)
+		f.Add(data)
+	}
+
+	sets := WrapperSets()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		// the first byte selects the wrappers.
+		cfg := Config{Stores: 2, Wrappers: sets[int(data[0])%len(sets)]}
+		ops := DecodeOps(data[1:], 2)
+		if err := Run(cfg, ops); err != nil {
+			t.Fatalf("wrappers %v: %v\nminimal ops: %v", cfg.Wrappers, err, Minimize(cfg, ops))
+		}
+	})
+}
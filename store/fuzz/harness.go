@@ -0,0 +1,587 @@
+package fuzz
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/gaskv"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+// Wrapper is a store wrapper applied to the stores of the branches.
+type Wrapper uint8
+
+// The wrappers are applied innermost first, in the order of the constants.
+const (
+	CacheKV Wrapper = iota
+	GasKV
+	Prefix
+	ListenKV
+	TraceKV
+
+	numWrappers
+)
+
+var wrapperNames = map[Wrapper]string{
+	CacheKV:  "cachekv",
+	GasKV:    "gaskv",
+	Prefix:   "prefix",
+	ListenKV: "listenkv",
+	TraceKV:  "tracekv",
+}
+
+func (w Wrapper) String() string {
+	if name, ok := wrapperNames[w]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Wrapper(%d)", w)
+}
+
+// WrapperSets returns every combination of wrappers.
+func WrapperSets() [][]Wrapper {
+	sets := make([][]Wrapper, 0, 1<<numWrappers)
+	for mask := 0; mask < 1<<numWrappers; mask++ {
+		set := []Wrapper{}
+		for w := Wrapper(0); w < numWrappers; w++ {
+			if mask&(1<<w) != 0 {
+				set = append(set, w)
+			}
+		}
+		sets = append(sets, set)
+	}
+
+	return sets
+}
+
+// keyPrefix is the prefix of the Prefix wrapper.
+var keyPrefix = []byte("p/")
+
+// Config is the configuration of a Run.
+type Config struct {
+	// Stores is the number of IAVL stores of the multistore.
+	Stores int
+	// Wrappers are the wrappers of the stores of the branches, applied
+	// innermost first in the order of the Wrapper constants, whatever their
+	// order.
+	Wrappers []Wrapper
+}
+
+func (cfg Config) has(w Wrapper) bool {
+	for _, cw := range cfg.Wrappers {
+		if cw == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run applies ops to a rootmulti.Store through the branches and wrappers of
+// cfg and to the reference, and returns an error describing the first
+// difference, if any. The stores are committed once more after the last
+// operation.
+func Run(cfg Config, ops []Op) error {
+	h, err := newHarness(cfg)
+	if err != nil {
+		return err
+	}
+
+	for i, op := range ops {
+		if op.Store < 0 || op.Store >= cfg.Stores {
+			return fmt.Errorf("op %d (%s): no store %d", i, op, op.Store)
+		}
+		if err := h.apply(op); err != nil {
+			return fmt.Errorf("op %d (%s): %w", i, op, err)
+		}
+	}
+
+	if err := h.commit(); err != nil {
+		return fmt.Errorf("final commit: %w", err)
+	}
+
+	return h.checkOutputs()
+}
+
+// harness applies the operations to the system under test, a rootmulti.Store
+// accessed through branches and wrappers, and to the reference: a model of the
+// branches above a rootmulti.Store written to directly.
+type harness struct {
+	cfg  Config
+	keys []types.StoreKey
+
+	ms       *rootmulti.Store
+	branches []*branch
+	listener *types.MemoryListener
+	trace    bytes.Buffer
+
+	ref      *rootmulti.Store
+	overlays []overlay
+
+	expectedWrites []*types.StoreKVPair
+	expectedTrace  []traceEntry
+}
+
+// branch is a branch of the multistore under test, and the wrapped stores
+// opened on it.
+type branch struct {
+	cms   types.CacheMultiStore
+	views map[int]*view
+}
+
+// view is a store of a branch accessed through the wrappers.
+type view struct {
+	store types.KVStore
+	cache *cachekv.Store
+}
+
+// overlay is the model of the writes of a branch by store, a nil value
+// marking a deleted key.
+type overlay map[int]map[string][]byte
+
+// traceEntry is an operation traced by tracekv.
+type traceEntry struct {
+	Operation string `json:"operation"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Store     string `json:"-"`
+}
+
+type kvPair struct {
+	key, value []byte
+}
+
+func newHarness(cfg Config) (*harness, error) {
+	if cfg.Stores <= 0 {
+		return nil, fmt.Errorf("invalid number of stores %d", cfg.Stores)
+	}
+
+	h := &harness{
+		cfg:      cfg,
+		listener: types.NewMemoryListener(),
+	}
+
+	for i := 0; i < cfg.Stores; i++ {
+		h.keys = append(h.keys, types.NewKVStoreKey(fmt.Sprintf("store%d", i)))
+	}
+
+	var err error
+	if h.ms, err = h.newMultiStore(); err != nil {
+		return nil, err
+	}
+	if h.ref, err = h.newMultiStore(); err != nil {
+		return nil, err
+	}
+
+	h.resetBranches()
+
+	return h, nil
+}
+
+func (h *harness) newMultiStore() (*rootmulti.Store, error) {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range h.keys {
+		ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+
+	return ms, ms.LoadLatestVersion()
+}
+
+// resetBranches starts a new block branch, with no branch above it.
+func (h *harness) resetBranches() {
+	h.branches = []*branch{{cms: h.ms.CacheMultiStore(), views: map[int]*view{}}}
+	h.overlays = []overlay{{}}
+}
+
+func (h *harness) top() *branch {
+	return h.branches[len(h.branches)-1]
+}
+
+// view returns the store of the current branch, through the wrappers.
+func (h *harness) view(i int) types.KVStore {
+	b := h.top()
+	if v, ok := b.views[i]; ok {
+		return v.store
+	}
+
+	v := &view{store: b.cms.GetKVStore(h.keys[i])}
+	for w := Wrapper(0); w < numWrappers; w++ {
+		if !h.cfg.has(w) {
+			continue
+		}
+
+		switch w {
+		case CacheKV:
+			v.cache = cachekv.NewStore(v.store)
+			v.store = v.cache
+		case GasKV:
+			v.store = gaskv.NewStore(v.store, types.NewInfiniteGasMeter(), types.KVGasConfig())
+		case Prefix:
+			v.store = prefix.NewStore(v.store, keyPrefix)
+		case ListenKV:
+			v.store = listenkv.NewStore(v.store, h.keys[i], h.listener)
+		case TraceKV:
+			v.store = tracekv.NewStore(v.store, &h.trace, types.TraceContext{"store": h.keys[i].Name()})
+		}
+	}
+	b.views[i] = v
+
+	return v.store
+}
+
+// flushViews writes the cachekv wrappers of the current branch to the branch.
+func (h *harness) flushViews() {
+	b := h.top()
+	for _, v := range b.views {
+		if v.cache != nil {
+			v.cache.Write()
+		}
+	}
+	b.views = map[int]*view{}
+}
+
+func (h *harness) apply(op Op) error {
+	switch op.Kind {
+	case OpSet:
+		h.view(op.Store).Set(op.Key, op.Value)
+		h.set(op.Store, op.Key, op.Value)
+		h.expectWrite(op.Store, op.Key, op.Value)
+
+	case OpDelete:
+		h.view(op.Store).Delete(op.Key)
+		h.set(op.Store, op.Key, nil)
+		h.expectWrite(op.Store, op.Key, nil)
+
+	case OpGet:
+		got := h.view(op.Store).Get(op.Key)
+		expected := h.get(op.Store, op.Key)
+		h.expectTrace(op.Store, "read", op.Key, expected)
+		if !bytes.Equal(got, expected) {
+			return fmt.Errorf("got %X, expected %X", got, expected)
+		}
+
+	case OpIterate, OpReverseIterate, OpDeleteIterated:
+		return h.iterate(op)
+
+	case OpBranch:
+		h.flushViews()
+		h.branches = append(h.branches, &branch{cms: h.top().cms.CacheMultiStore(), views: map[int]*view{}})
+		h.overlays = append(h.overlays, overlay{})
+
+	case OpWrite:
+		h.write()
+
+	case OpDiscard:
+		if len(h.branches) == 1 {
+			h.resetBranches()
+			break
+		}
+		h.branches = h.branches[:len(h.branches)-1]
+		h.overlays = h.overlays[:len(h.overlays)-1]
+
+	case OpCommit:
+		return h.commit()
+
+	default:
+		return fmt.Errorf("unknown operation")
+	}
+
+	return nil
+}
+
+func (h *harness) iterate(op Op) error {
+	store := h.view(op.Store)
+
+	var it types.Iterator
+	if op.Kind == OpReverseIterate {
+		it = store.ReverseIterator(op.Key, op.End)
+	} else {
+		it = store.Iterator(op.Key, op.End)
+	}
+	defer it.Close()
+
+	expected := h.rangeOf(op.Store, op.Key, op.End)
+	if op.Kind == OpReverseIterate {
+		for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+			expected[i], expected[j] = expected[j], expected[i]
+		}
+	}
+
+	n := 0
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if n >= len(expected) {
+			return fmt.Errorf("unexpected key %q", key)
+		}
+		h.expectTrace(op.Store, "iterKey", expected[n].key, nil)
+		h.expectTrace(op.Store, "iterValue", nil, expected[n].value)
+		if !bytes.Equal(key, expected[n].key) || !bytes.Equal(value, expected[n].value) {
+			return fmt.Errorf("got %q=%X, expected %q=%X", key, value, expected[n].key, expected[n].value)
+		}
+
+		if op.Kind == OpDeleteIterated && n%2 == 0 {
+			store.Delete(key)
+			h.set(op.Store, key, nil)
+			h.expectWrite(op.Store, key, nil)
+		}
+		n++
+	}
+	if n != len(expected) {
+		return fmt.Errorf("iterated %d keys, expected %d", n, len(expected))
+	}
+
+	// the iterators of cachekv report an error once exhausted.
+	return nil
+}
+
+// write writes the current branch to its parent, or to the multistore for
+// the block branch.
+func (h *harness) write() {
+	h.flushViews()
+	h.top().cms.Write()
+
+	top := h.overlays[len(h.overlays)-1]
+	if len(h.branches) == 1 {
+		for i, writes := range top {
+			keys := make([]string, 0, len(writes))
+			for key := range writes {
+				keys = append(keys, key)
+			}
+			// the shape of an IAVL tree depends on the order of the writes,
+			// which are written back by the branches in key order.
+			sort.Strings(keys)
+
+			store := h.ref.GetKVStore(h.keys[i])
+			for _, key := range keys {
+				value := writes[key]
+				if value == nil {
+					store.Delete(h.refKey([]byte(key)))
+				} else {
+					store.Set(h.refKey([]byte(key)), value)
+				}
+			}
+		}
+		h.overlays[0] = overlay{}
+		return
+	}
+
+	parent := h.overlays[len(h.overlays)-2]
+	for i, writes := range top {
+		if parent[i] == nil {
+			parent[i] = map[string][]byte{}
+		}
+		for key, value := range writes {
+			parent[i][key] = value
+		}
+	}
+
+	h.branches = h.branches[:len(h.branches)-1]
+	h.overlays = h.overlays[:len(h.overlays)-1]
+}
+
+// commit writes all the branches and commits both multistores.
+func (h *harness) commit() error {
+	for len(h.branches) > 1 {
+		h.write()
+	}
+	h.write()
+
+	got := h.ms.Commit()
+	expected := h.ref.Commit()
+	h.resetBranches()
+
+	if got.Version != expected.Version || !bytes.Equal(got.Hash, expected.Hash) {
+		return fmt.Errorf("got commit %s, expected %s", got, expected)
+	}
+
+	return nil
+}
+
+// set records a write in the model of the current branch.
+func (h *harness) set(i int, key, value []byte) {
+	top := h.overlays[len(h.overlays)-1]
+	if top[i] == nil {
+		top[i] = map[string][]byte{}
+	}
+	top[i][string(key)] = value
+}
+
+// get returns the value of a key in the model of the current branch.
+func (h *harness) get(i int, key []byte) []byte {
+	for j := len(h.overlays) - 1; j >= 0; j-- {
+		if value, ok := h.overlays[j][i][string(key)]; ok {
+			return value
+		}
+	}
+
+	return h.ref.GetKVStore(h.keys[i]).Get(h.refKey(key))
+}
+
+// rangeOf returns the sorted pairs of [start, end) in the model of the current
+// branch.
+func (h *harness) rangeOf(i int, start, end []byte) []kvPair {
+	inRange := func(key []byte) bool {
+		return (start == nil || bytes.Compare(key, start) >= 0) && (end == nil || bytes.Compare(key, end) < 0)
+	}
+
+	keys := map[string]struct{}{}
+
+	refStart, refEnd := h.refKey(start), h.refKey(end)
+	strip := 0
+	if h.cfg.has(Prefix) {
+		strip = len(keyPrefix)
+		if start == nil {
+			refStart = keyPrefix
+		}
+		if end == nil {
+			refEnd = types.PrefixEndBytes(keyPrefix)
+		}
+	}
+	it := h.ref.GetKVStore(h.keys[i]).Iterator(refStart, refEnd)
+	for ; it.Valid(); it.Next() {
+		keys[string(it.Key()[strip:])] = struct{}{}
+	}
+	it.Close()
+
+	for _, o := range h.overlays {
+		for key := range o[i] {
+			if inRange([]byte(key)) {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+
+	pairs := make([]kvPair, 0, len(keys))
+	for key := range keys {
+		if value := h.get(i, []byte(key)); value != nil {
+			pairs = append(pairs, kvPair{key: []byte(key), value: value})
+		}
+	}
+	sort.Slice(pairs, func(a, b int) bool { return bytes.Compare(pairs[a].key, pairs[b].key) < 0 })
+
+	return pairs
+}
+
+// refKey returns the key of the reference IAVL store for a key of a branch.
+func (h *harness) refKey(key []byte) []byte {
+	if key == nil || !h.cfg.has(Prefix) {
+		return key
+	}
+
+	return append(append([]byte{}, keyPrefix...), key...)
+}
+
+// expectWrite records a write expected to be seen by the wrappers.
+func (h *harness) expectWrite(i int, key, value []byte) {
+	if h.cfg.has(ListenKV) {
+		h.expectedWrites = append(h.expectedWrites, &types.StoreKVPair{
+			StoreKey: h.keys[i].Name(),
+			Delete:   value == nil,
+			Key:      key,
+			Value:    value,
+		})
+	}
+
+	if value == nil {
+		h.expectTrace(i, "delete", key, nil)
+	} else {
+		h.expectTrace(i, "write", key, value)
+	}
+}
+
+// expectTrace records an operation expected to be traced.
+func (h *harness) expectTrace(i int, operation string, key, value []byte) {
+	if !h.cfg.has(TraceKV) {
+		return
+	}
+
+	h.expectedTrace = append(h.expectedTrace, traceEntry{
+		Operation: operation,
+		Key:       base64.StdEncoding.EncodeToString(key),
+		Value:     base64.StdEncoding.EncodeToString(value),
+		Store:     h.keys[i].Name(),
+	})
+}
+
+// checkOutputs checks the writes seen by listenkv and the operations traced
+// by tracekv.
+func (h *harness) checkOutputs() error {
+	writes := h.listener.PopStateCache()
+	if len(writes) != len(h.expectedWrites) {
+		return fmt.Errorf("listener got %d writes, expected %d", len(writes), len(h.expectedWrites))
+	}
+	for i, w := range writes {
+		e := h.expectedWrites[i]
+		if w.StoreKey != e.StoreKey || w.Delete != e.Delete || !bytes.Equal(w.Key, e.Key) || !bytes.Equal(w.Value, e.Value) {
+			return fmt.Errorf("listener write %d: got %s, expected %s", i, w, e)
+		}
+	}
+
+	var traced []traceEntry
+	scanner := bufio.NewScanner(&h.trace)
+	for scanner.Scan() {
+		var entry struct {
+			traceEntry
+			Metadata map[string]any `json:"metadata"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("invalid trace %q: %w", scanner.Text(), err)
+		}
+		entry.Store, _ = entry.Metadata["store"].(string)
+		traced = append(traced, entry.traceEntry)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(traced) != len(h.expectedTrace) {
+		return fmt.Errorf("got %d traced operations, expected %d", len(traced), len(h.expectedTrace))
+	}
+	for i, entry := range traced {
+		if entry != h.expectedTrace[i] {
+			return fmt.Errorf("traced operation %d: got %+v, expected %+v", i, entry, h.expectedTrace[i])
+		}
+	}
+
+	return nil
+}
+
+// Minimize returns a minimal subsequence of ops for which Run fails, to
+// report a failure found by a random sequence. It returns ops if Run succeeds.
+func Minimize(cfg Config, ops []Op) []Op {
+	if Run(cfg, ops) == nil {
+		return ops
+	}
+
+	// the shortest failing prefix, then remove the ops one at a time.
+	for n := 1; n < len(ops); n++ {
+		if Run(cfg, ops[:n]) != nil {
+			ops = ops[:n]
+			break
+		}
+	}
+
+	for i := 0; i < len(ops); {
+		candidate := append(append([]Op{}, ops[:i]...), ops[i+1:]...)
+		if Run(cfg, candidate) != nil {
+			ops = candidate
+			continue
+		}
+		i++
+	}
+
+	return ops
+}
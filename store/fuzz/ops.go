@@ -0,0 +1,211 @@
+// Package fuzz checks that the store wrappers and branches commit the same
+// state as applying the same operations straight to IAVL.
+//
+// Run applies a sequence of operations to a rootmulti.Store through nested
+// cachemulti branches, each store being accessed through a stack of wrappers,
+// and to a reference rootmulti.Store whose IAVL stores only receive the writes
+// left once the branches are written back or discarded. It checks every read
+// and iteration against the reference, the CommitID of every commit, and the
+// outputs of the listenkv and tracekv wrappers against the operations applied.
+//
+// The operations are generated by RandomOps, or decoded from the input of a Go
+// fuzz test by DecodeOps.
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+)
+
+// OpKind is the kind of an operation.
+type OpKind uint8
+
+const (
+	// OpSet sets Key to Value in Store.
+	OpSet OpKind = iota
+	// OpDelete deletes Key from Store.
+	OpDelete
+	// OpGet reads Key from Store.
+	OpGet
+	// OpIterate iterates over [Key, End) of Store, nil meaning unbounded.
+	OpIterate
+	// OpReverseIterate iterates over [Key, End) of Store in reverse order.
+	OpReverseIterate
+	// OpDeleteIterated iterates over [Key, End) of Store and deletes every
+	// other key while iterating.
+	OpDeleteIterated
+	// OpBranch branches the current branch.
+	OpBranch
+	// OpWrite writes the current branch to its parent.
+	OpWrite
+	// OpDiscard discards the current branch.
+	OpDiscard
+	// OpCommit writes all the branches and commits.
+	OpCommit
+)
+
+var opKindNames = map[OpKind]string{
+	OpSet:            "set",
+	OpDelete:         "delete",
+	OpGet:            "get",
+	OpIterate:        "iterate",
+	OpReverseIterate: "reverse-iterate",
+	OpDeleteIterated: "delete-iterated",
+	OpBranch:         "branch",
+	OpWrite:          "write",
+	OpDiscard:        "discard",
+	OpCommit:         "commit",
+}
+
+func (k OpKind) String() string {
+	if name, ok := opKindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("OpKind(%d)", k)
+}
+
+// opKinds are the kinds of the generated operations, weighted by their number
+// of occurrences.
+var opKinds = []OpKind{
+	OpSet, OpSet, OpSet, OpSet,
+	OpDelete, OpDelete,
+	OpGet, OpGet,
+	OpIterate,
+	OpReverseIterate,
+	OpDeleteIterated,
+	OpBranch, OpBranch,
+	OpWrite,
+	OpDiscard,
+	OpCommit,
+}
+
+// keyAlphabet is small so that the generated keys collide often.
+const keyAlphabet = "abcdefgh"
+
+// Op is an operation applied to a store of a multistore. Store is the index
+// of the store in the multistore.
+type Op struct {
+	Kind  OpKind
+	Store int
+	Key   []byte
+	Value []byte
+	End   []byte
+}
+
+func (op Op) String() string {
+	switch op.Kind {
+	case OpSet:
+		return fmt.Sprintf("%s %d %q=%X", op.Kind, op.Store, op.Key, op.Value)
+	case OpDelete, OpGet:
+		return fmt.Sprintf("%s %d %q", op.Kind, op.Store, op.Key)
+	case OpIterate, OpReverseIterate, OpDeleteIterated:
+		return fmt.Sprintf("%s %d [%q, %q)", op.Kind, op.Store, op.Key, op.End)
+	default:
+		return op.Kind.String()
+	}
+}
+
+// source is a source of random bytes.
+type source interface {
+	next() byte
+}
+
+type randSource struct {
+	r *rand.Rand
+}
+
+func (s randSource) next() byte {
+	return byte(s.r.Intn(256))
+}
+
+// dataSource reads the bytes of data, and zeros once they are exhausted.
+type dataSource struct {
+	data []byte
+}
+
+func (s *dataSource) next() byte {
+	if len(s.data) == 0 {
+		return 0
+	}
+
+	b := s.data[0]
+	s.data = s.data[1:]
+
+	return b
+}
+
+// RandomOps returns n random operations on a multistore of the given number
+// of stores.
+func RandomOps(r *rand.Rand, n, stores int) []Op {
+	src := randSource{r: r}
+
+	ops := make([]Op, n)
+	for i := range ops {
+		ops[i] = genOp(src, stores)
+	}
+
+	return ops
+}
+
+// DecodeOps decodes a sequence of operations on a multistore of the given
+// number of stores from data. Every input decodes to a valid sequence, so that
+// it can decode the input of a Go fuzz test.
+func DecodeOps(data []byte, stores int) []Op {
+	src := &dataSource{data: data}
+
+	var ops []Op
+	for len(src.data) > 0 {
+		ops = append(ops, genOp(src, stores))
+	}
+
+	return ops
+}
+
+func genOp(src source, stores int) Op {
+	op := Op{
+		Kind:  opKinds[int(src.next())%len(opKinds)],
+		Store: int(src.next()) % stores,
+	}
+
+	switch op.Kind {
+	case OpSet:
+		op.Key = genKey(src)
+		op.Value = make([]byte, 1+int(src.next())%3)
+		for i := range op.Value {
+			op.Value[i] = src.next()
+		}
+
+	case OpDelete, OpGet:
+		op.Key = genKey(src)
+
+	case OpIterate, OpReverseIterate, OpDeleteIterated:
+		if src.next()%4 != 0 {
+			op.Key = genKey(src)
+		}
+		if src.next()%4 != 0 {
+			op.End = genKey(src)
+		}
+
+		if op.Key != nil && op.End != nil {
+			switch bytes.Compare(op.Key, op.End) {
+			case 0:
+				op.End = nil
+			case 1:
+				op.Key, op.End = op.End, op.Key
+			}
+		}
+	}
+
+	return op
+}
+
+func genKey(src source) []byte {
+	key := make([]byte, 1+int(src.next())%2)
+	for i := range key {
+		key[i] = keyAlphabet[int(src.next())%len(keyAlphabet)]
+	}
+
+	return key
+}
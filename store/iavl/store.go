@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -38,6 +39,11 @@ type Store struct {
 	tree    Tree
 	logger  log.Logger
 	metrics metrics.StoreMetrics
+
+	// storeKey and stat are set when metrics are enabled, the IAVL node cache
+	// statistics are reported on every commit.
+	storeKey string
+	stat     *iavl.Statistics
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -52,7 +58,12 @@ func LoadStore(db dbm.DB, logger log.Logger, key types.StoreKey, id types.Commit
 // provided DB. An error is returned if the version fails to load, or if called with a positive
 // version on an empty tree.
 func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, lazyLoading bool, initialVersion uint64, cacheSize int, disableFastNode bool, metrics metrics.StoreMetrics) (types.CommitKVStore, error) {
-	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion}, disableFastNode)
+	var stat *iavl.Statistics
+	if metricsEnabled(metrics) {
+		stat = &iavl.Statistics{}
+	}
+
+	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion, Stat: stat}, disableFastNode)
 	if err != nil {
 		return nil, err
 	}
@@ -86,11 +97,22 @@ func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKe
 		logger.Debug("Finished loading IAVL tree")
 	}
 
-	return &Store{
+	store := &Store{
 		tree:    tree,
 		logger:  logger,
 		metrics: metrics,
-	}, nil
+		stat:    stat,
+	}
+	if key != nil {
+		store.storeKey = key.Name()
+	}
+
+	return store, nil
+}
+
+func metricsEnabled(m metrics.StoreMetrics) bool {
+	_, noop := m.(metrics.NoOpMetrics)
+	return m != nil && !noop
 }
 
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
@@ -137,12 +159,28 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	st.reportCacheStats()
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
 	}
 }
 
+// reportCacheStats reports the IAVL node cache hits and misses since the
+// previous commit.
+func (st *Store) reportCacheStats() {
+	if st.stat == nil {
+		return
+	}
+
+	st.metrics.IncrStoreCounter(st.storeKey, float32(st.stat.GetCacheHitCnt()), "store", "iavl", "cache", "hit")
+	st.metrics.IncrStoreCounter(st.storeKey, float32(st.stat.GetCacheMissCnt()), "store", "iavl", "cache", "miss")
+	st.metrics.IncrStoreCounter(st.storeKey, float32(st.stat.GetFastCacheHitCnt()), "store", "iavl", "fast_cache", "hit")
+	st.metrics.IncrStoreCounter(st.storeKey, float32(st.stat.GetFastCacheMissCnt()), "store", "iavl", "fast_cache", "miss")
+	st.stat.Reset()
+}
+
 // LastCommitID implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	hash, err := st.tree.Hash()
@@ -369,11 +407,41 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		if req.Prove {
+			// The proven pairs must be read from the queried version, so that
+			// they match the root of the range proof.
+			if !st.VersionExists(res.Height) {
+				res.Log = iavl.ErrVersionDoesNotExist.Error()
+				break
+			}
+
+			iTree, err := tree.GetImmutable(res.Height)
+			if err != nil {
+				panic(fmt.Sprintf("version exists in store but could not retrieve corresponding versioned tree in store, %s", err.Error()))
+			}
+
+			end := types.PrefixEndBytes(subspace)
+			iterator, err := iTree.Iterator(subspace, end, true)
+			if err != nil {
+				panic(err)
+			}
+			for ; iterator.Valid(); iterator.Next() {
+				pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+			}
+			iterator.Close()
+
+			proof, err := getRangeProofFromTree(iTree, subspace, end, pairs.Pairs)
+			if err != nil {
+				return types.QueryResult(errorsmod.Wrap(types.ErrInvalidRequest, err.Error()), false)
+			}
+			res.ProofOps = proof
+		} else {
+			iterator := types.KVStorePrefixIterator(st, subspace)
+			for ; iterator.Valid(); iterator.Next() {
+				pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+			}
+			iterator.Close()
 		}
-		iterator.Close()
 
 		bz, err := pairs.Marshal()
 		if err != nil {
@@ -422,3 +490,48 @@ func getProofFromTree(tree *iavl.MutableTree, key []byte, exists bool) *cmtproto
 	op := types.NewIavlCommitmentOp(key, commitmentProof)
 	return &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op.ProofOp()}}
 }
+
+// getRangeProofFromTree returns a batch proof that pairs are all the entries of
+// the [start, end) range of tree. It holds the existence proofs of the pairs and
+// the proofs of the start and end bounds, whose neighbors close the range. The
+// proof can be verified by types.CommitmentOp.RunRange.
+func getRangeProofFromTree(tree *iavl.ImmutableTree, start, end []byte, pairs []kv.Pair) (*cmtprotocrypto.ProofOps, error) {
+	if tree.Size() == 0 {
+		return nil, errors.New("cannot create range proof for empty tree")
+	}
+
+	proofs := make([]*ics23.CommitmentProof, 0, len(pairs)+2)
+	for _, pair := range pairs {
+		proof, err := tree.GetMembershipProof(pair.Key)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+
+	// the start bound is already proven when it is the first pair, and the
+	// first pair is the left-most leaf when start is empty.
+	if len(start) > 0 && (len(pairs) == 0 || !bytes.Equal(pairs[0].Key, start)) {
+		proof, err := tree.GetNonMembershipProof(start)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+
+	if end != nil {
+		proof, err := tree.GetProof(end)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+
+	batch, err := ics23.CombineProofs(proofs)
+	if err != nil {
+		return nil, err
+	}
+
+	op := types.NewIavlCommitmentOp(start, batch)
+	return &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op.ProofOp()}}, nil
+}
@@ -3,6 +3,7 @@ package iavl
 import (
 	crand "crypto/rand"
 	"fmt"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -657,3 +658,43 @@ func TestCacheWraps(t *testing.T) {
 	cacheWrappedWithTrace := store.CacheWrapWithTrace(nil, nil)
 	require.IsType(t, &cachekv.Store{}, cacheWrappedWithTrace)
 }
+
+type counterMetrics struct {
+	metrics.NoOpMetrics
+	counters map[string]float32
+}
+
+func (m *counterMetrics) IncrStoreCounter(storeKey string, val float32, keys ...string) {
+	m.counters[storeKey+"/"+strings.Join(keys, ".")] += val
+}
+
+func TestIAVLCacheMetrics(t *testing.T) {
+	m := &counterMetrics{counters: make(map[string]float32)}
+	db := dbm.NewMemDB()
+	store, err := LoadStore(db, log.NewNopLogger(), types.NewKVStoreKey("test"), types.CommitID{}, false, DefaultIAVLCacheSize, false, m)
+	require.NoError(t, err)
+
+	iavlStore := store.(*Store)
+	for k, v := range treeData {
+		iavlStore.Set([]byte(k), []byte(v))
+	}
+	iavlStore.Commit()
+
+	for k := range treeData {
+		iavlStore.Get([]byte(k))
+		iavlStore.Get([]byte(k))
+	}
+	iavlStore.Commit()
+
+	// every Get looks up the fast node cache.
+	lookups := m.counters["test/store.iavl.fast_cache.hit"] + m.counters["test/store.iavl.fast_cache.miss"]
+	require.Equal(t, float32(2*len(treeData)), lookups)
+
+	// the statistics are reset after being reported.
+	require.Zero(t, iavlStore.stat.GetFastCacheHitCnt())
+
+	// no statistics are collected without metrics.
+	store, err = LoadStore(db, log.NewNopLogger(), types.NewKVStoreKey("test"), types.CommitID{}, false, DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	require.Nil(t, store.(*Store).stat)
+}
@@ -0,0 +1,167 @@
+package metrickv
+
+import (
+	"io"
+	"time"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	_ types.KVStore    = &Store{}
+	_ metrics.Reporter = &Store{}
+)
+
+// Store implements the KVStore interface with per-store metrics. The latency
+// of every core KVStore call, the bytes read and written and the iterators are
+// reported under the name of the store key.
+type Store struct {
+	parent   types.KVStore
+	storeKey string
+	metrics  metrics.StoreMetrics
+}
+
+// NewStore returns a reference to a new metrics Store given a parent KVStore
+// implementation, the key of the store and a metrics gatherer.
+func NewStore(parent types.KVStore, storeKey types.StoreKey, metrics metrics.StoreMetrics) *Store {
+	return &Store{parent: parent, storeKey: storeKey.Name(), metrics: metrics}
+}
+
+// StoreMetrics implements metrics.Reporter.
+func (s *Store) StoreMetrics() (string, metrics.StoreMetrics) {
+	return s.storeKey, s.metrics
+}
+
+// Get implements the KVStore interface. It measures the read and delegates the
+// Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	defer s.metrics.MeasureStoreSince(s.storeKey, time.Now(), "store", "get")
+
+	value := s.parent.Get(key)
+	s.metrics.IncrStoreCounter(s.storeKey, float32(len(key)+len(value)), "store", "read_bytes")
+	return value
+}
+
+// Set implements the KVStore interface. It measures the write and delegates
+// the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	defer s.metrics.MeasureStoreSince(s.storeKey, time.Now(), "store", "set")
+
+	s.parent.Set(key, value)
+	s.metrics.IncrStoreCounter(s.storeKey, float32(len(key)+len(value)), "store", "write_bytes")
+}
+
+// Delete implements the KVStore interface. It measures the write and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	defer s.metrics.MeasureStoreSince(s.storeKey, time.Now(), "store", "delete")
+
+	s.parent.Delete(key)
+	s.metrics.IncrStoreCounter(s.storeKey, float32(len(key)), "store", "write_bytes")
+}
+
+// Has implements the KVStore interface. It measures the read and delegates the
+// Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	defer s.metrics.MeasureStoreSince(s.storeKey, time.Now(), "store", "has")
+
+	s.metrics.IncrStoreCounter(s.storeKey, float32(len(key)), "store", "read_bytes")
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// iterator counts the creation of an iterator and wraps the parent iterator
+// so that its steps and the bytes it read are reported once it is closed.
+func (s *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	s.metrics.IncrStoreCounter(s.storeKey, 1, "store", "iterator")
+
+	var parent types.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	return &metricIterator{parent: parent, store: s}
+}
+
+type metricIterator struct {
+	parent types.Iterator
+	store  *Store
+	steps  int
+	bytes  int
+}
+
+// Domain implements the Iterator interface.
+func (mi *metricIterator) Domain() (start []byte, end []byte) {
+	return mi.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (mi *metricIterator) Valid() bool {
+	return mi.parent.Valid()
+}
+
+// Next implements the Iterator interface.
+func (mi *metricIterator) Next() {
+	mi.steps++
+	mi.parent.Next()
+}
+
+// Key implements the Iterator interface.
+func (mi *metricIterator) Key() []byte {
+	key := mi.parent.Key()
+	mi.bytes += len(key)
+	return key
+}
+
+// Value implements the Iterator interface.
+func (mi *metricIterator) Value() []byte {
+	value := mi.parent.Value()
+	mi.bytes += len(value)
+	return value
+}
+
+// Close implements the Iterator interface. It reports the steps and the bytes
+// read by the iterator.
+func (mi *metricIterator) Close() error {
+	mi.store.metrics.AddStoreSample(mi.store.storeKey, float32(mi.steps), "store", "iterator", "steps")
+	mi.store.metrics.IncrStoreCounter(mi.store.storeKey, float32(mi.bytes), "store", "read_bytes")
+	return mi.parent.Close()
+}
+
+// Error delegates the Error call to the parent iterator.
+func (mi *metricIterator) Error() error {
+	return mi.parent.Error()
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The branch reports its cache
+// hits and misses under the same store key.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
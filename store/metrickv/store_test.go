@@ -0,0 +1,131 @@
+package metrickv_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/metrickv"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("metric_test")
+
+// mockMetrics records the per-store metrics by "<store key>/<key>".
+type mockMetrics struct {
+	metrics.NoOpMetrics
+
+	mtx      sync.Mutex
+	counters map[string]float32
+	samples  map[string][]float32
+	measures map[string]int
+}
+
+func newMockMetrics() *mockMetrics {
+	return &mockMetrics{
+		counters: make(map[string]float32),
+		samples:  make(map[string][]float32),
+		measures: make(map[string]int),
+	}
+}
+
+func name(storeKey string, keys []string) string {
+	return storeKey + "/" + strings.Join(keys, ".")
+}
+
+func (m *mockMetrics) MeasureStoreSince(storeKey string, _ time.Time, keys ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.measures[name(storeKey, keys)]++
+}
+
+func (m *mockMetrics) IncrStoreCounter(storeKey string, val float32, keys ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.counters[name(storeKey, keys)] += val
+}
+
+func (m *mockMetrics) AddStoreSample(storeKey string, val float32, keys ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.samples[name(storeKey, keys)] = append(m.samples[name(storeKey, keys)], val)
+}
+
+func newMetricKVStore(m metrics.StoreMetrics) *metrickv.Store {
+	return metrickv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, testStoreKey, m)
+}
+
+func TestMetricKVStoreOperations(t *testing.T) {
+	m := newMockMetrics()
+	store := newMetricKVStore(m)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.Nil(t, store.Get([]byte("missing")))
+	require.True(t, store.Has([]byte("key2")))
+	store.Delete([]byte("key2"))
+
+	require.Equal(t, 2, m.measures["metric_test/store.set"])
+	require.Equal(t, 2, m.measures["metric_test/store.get"])
+	require.Equal(t, 1, m.measures["metric_test/store.has"])
+	require.Equal(t, 1, m.measures["metric_test/store.delete"])
+
+	require.Equal(t, float32(2*10+4), m.counters["metric_test/store.write_bytes"])
+	require.Equal(t, float32(10+7+4), m.counters["metric_test/store.read_bytes"])
+}
+
+func TestMetricKVStoreIterator(t *testing.T) {
+	m := newMockMetrics()
+	store := newMetricKVStore(m)
+
+	for _, key := range []string{"a", "b", "c"} {
+		store.Set([]byte(key), []byte(key))
+	}
+
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		_ = iter.Key()
+		_ = iter.Value()
+	}
+	require.NoError(t, iter.Close())
+
+	iter = store.ReverseIterator([]byte("b"), nil)
+	require.Equal(t, []byte("c"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, float32(2), m.counters["metric_test/store.iterator"])
+	require.Equal(t, []float32{3, 0}, m.samples["metric_test/store.iterator.steps"])
+	require.Equal(t, float32(6+1), m.counters["metric_test/store.read_bytes"])
+}
+
+func TestMetricKVStoreCacheHits(t *testing.T) {
+	m := newMockMetrics()
+	store := newMetricKVStore(m)
+	store.Set([]byte("key"), []byte("value"))
+
+	cache := store.CacheWrap().(*cachekv.Store)
+	cache.Get([]byte("key"))
+	cache.Get([]byte("key"))
+
+	// nested branches report under the same store key.
+	nested := cachekv.NewStore(cache)
+	nested.Get([]byte("key"))
+
+	require.Equal(t, float32(2), m.counters["metric_test/store.cachekv.miss"])
+	require.Equal(t, float32(2), m.counters["metric_test/store.cachekv.hit"])
+
+	// a cache over a store without metrics doesn't report.
+	plain := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	key, sm := plain.StoreMetrics()
+	require.Empty(t, key)
+	require.Nil(t, sm)
+	plain.Get([]byte("key"))
+}
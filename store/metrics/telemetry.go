@@ -6,9 +6,26 @@ import (
 	"github.com/armon/go-metrics"
 )
 
+// StoreKeyLabel is the label identifying the store of the per-store metrics.
+const StoreKeyLabel = "store_key"
+
 // StoreMetrics defines the set of metrics for the store package
 type StoreMetrics interface {
 	MeasureSince(keys ...string)
+
+	// MeasureStoreSince emits a time measure metric of a store.
+	MeasureStoreSince(storeKey string, start time.Time, keys ...string)
+	// IncrStoreCounter increments a counter of a store.
+	IncrStoreCounter(storeKey string, val float32, keys ...string)
+	// AddStoreSample adds a sample to a histogram of a store.
+	AddStoreSample(storeKey string, val float32, keys ...string)
+}
+
+// Reporter is implemented by the stores reporting the metrics of a store key.
+// The stores branched from them report their own metrics, such as the cache
+// hits of a cachekv.Store, under the same store key.
+type Reporter interface {
+	StoreMetrics() (storeKey string, m StoreMetrics)
 }
 
 var (
@@ -44,6 +61,30 @@ func (m Metrics) MeasureSince(keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// MeasureStoreSince emits a time measure metric labeled with the store key and
+// the global labels (if any).
+func (m Metrics) MeasureStoreSince(storeKey string, start time.Time, keys ...string) {
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.storeLabels(storeKey))
+}
+
+// IncrStoreCounter increments a counter labeled with the store key and the
+// global labels (if any).
+func (m Metrics) IncrStoreCounter(storeKey string, val float32, keys ...string) {
+	metrics.IncrCounterWithLabels(keys, val, m.storeLabels(storeKey))
+}
+
+// AddStoreSample adds a sample to a histogram labeled with the store key and
+// the global labels (if any).
+func (m Metrics) AddStoreSample(storeKey string, val float32, keys ...string) {
+	metrics.AddSampleWithLabels(keys, val, m.storeLabels(storeKey))
+}
+
+func (m Metrics) storeLabels(storeKey string) []metrics.Label {
+	labels := make([]metrics.Label, 0, len(m.Labels)+1)
+	labels = append(labels, m.Labels...)
+	return append(labels, metrics.Label{Name: StoreKeyLabel, Value: storeKey})
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -54,3 +95,12 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(keys ...string) {}
+
+// MeasureStoreSince is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) MeasureStoreSince(storeKey string, start time.Time, keys ...string) {}
+
+// IncrStoreCounter is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) IncrStoreCounter(storeKey string, val float32, keys ...string) {}
+
+// AddStoreSample is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) AddStoreSample(storeKey string, val float32, keys ...string) {}
@@ -0,0 +1,192 @@
+package rootmulti
+
+import (
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// commitJournalKey records the version being committed while the IAVL stores
+// with their own database are committed, so that an interrupted commit is
+// detected when the Store is loaded.
+var commitJournalKey = []byte("s/commitjournal")
+
+// commitDB wraps the database of a Store for the IAVL stores sharing it. While
+// the Store commits, the batches written by the stores are gathered in a
+// single batch, which is written atomically with the commit info. Otherwise
+// the batches are written to the database as usual.
+type commitDB struct {
+	dbm.DB
+
+	mtx     sync.Mutex
+	pending dbm.Batch
+}
+
+var _ dbm.DB = (*commitDB)(nil)
+
+func newCommitDB(db dbm.DB) *commitDB {
+	return &commitDB{DB: db}
+}
+
+// begin starts gathering the batches of the stores.
+func (db *commitDB) begin() {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.pending = db.DB.NewBatch()
+}
+
+// end returns the batches gathered since begin, and stops gathering them. The
+// caller must write and close the batch.
+func (db *commitDB) end() dbm.Batch {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	batch := db.pending
+	db.pending = nil
+
+	return batch
+}
+
+// batch returns the batch gathering the batches of the stores, nil if the
+// Store is not committing.
+func (db *commitDB) batch() dbm.Batch {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	return db.pending
+}
+
+// NewBatch implements DB.
+func (db *commitDB) NewBatch() dbm.Batch {
+	return &commitBatch{db: db}
+}
+
+// NewBatchWithSize implements DB.
+func (db *commitDB) NewBatchWithSize(_ int) dbm.Batch {
+	return db.NewBatch()
+}
+
+// commitBatch is a batch of a commitDB. Its operations go to the batch of the
+// commit in progress if any, and are written on Write and WriteSync otherwise.
+type commitBatch struct {
+	db    *commitDB
+	batch dbm.Batch
+}
+
+var _ dbm.Batch = (*commitBatch)(nil)
+
+func (b *commitBatch) target() dbm.Batch {
+	if pending := b.db.batch(); pending != nil {
+		return pending
+	}
+	if b.batch == nil {
+		b.batch = b.db.DB.NewBatch()
+	}
+
+	return b.batch
+}
+
+// Set implements Batch.
+func (b *commitBatch) Set(key, value []byte) error {
+	return b.target().Set(key, value)
+}
+
+// Delete implements Batch.
+func (b *commitBatch) Delete(key []byte) error {
+	return b.target().Delete(key)
+}
+
+// Write implements Batch. The operations gathered in the batch of the commit
+// in progress are written with the commit info.
+func (b *commitBatch) Write() error {
+	return b.write(false)
+}
+
+// WriteSync implements Batch.
+func (b *commitBatch) WriteSync() error {
+	return b.write(true)
+}
+
+func (b *commitBatch) write(sync bool) (err error) {
+	if b.batch == nil {
+		return nil
+	}
+
+	if sync {
+		err = b.batch.WriteSync()
+	} else {
+		err = b.batch.Write()
+	}
+	if err != nil {
+		return err
+	}
+
+	err = b.batch.Close()
+	b.batch = nil
+
+	return err
+}
+
+// Close implements Batch.
+func (b *commitBatch) Close() error {
+	if b.batch == nil {
+		return nil
+	}
+
+	err := b.batch.Close()
+	b.batch = nil
+
+	return err
+}
+
+// GetByteSize implements Batch.
+func (b *commitBatch) GetByteSize() (int, error) {
+	return b.target().GetByteSize()
+}
+
+// hasOwnDBStores returns whether some IAVL stores have their own database, and
+// are thus not committed atomically with the commit info.
+func (rs *Store) hasOwnDBStores() bool {
+	for _, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL && params.db != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// repairStore deletes the versions of an IAVL store after version ver, which
+// were committed by a commit interrupted before the commit info was written.
+func (rs *Store) repairStore(key types.StoreKey, store types.CommitKVStore, ver int64) error {
+	if rs.interBlockCache != nil {
+		if unwrapped := rs.interBlockCache.Unwrap(key); unwrapped != nil {
+			store = unwrapped
+		}
+	}
+
+	iavlStore, ok := store.(*iavl.Store)
+	if !ok {
+		return nil
+	}
+
+	versions := iavlStore.GetAllVersions()
+	if len(versions) == 0 || int64(versions[len(versions)-1]) <= ver {
+		return nil
+	}
+
+	rs.logger.Info("repairing partially committed version", "store", key.Name(), "version", versions[len(versions)-1], "target", ver)
+
+	var err error
+	if rs.lazyLoading {
+		_, err = iavlStore.LazyLoadVersionForOverwriting(ver)
+	} else {
+		_, err = iavlStore.LoadVersionForOverwriting(ver)
+	}
+
+	return err
+}
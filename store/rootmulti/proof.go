@@ -1,18 +1,80 @@
 package rootmulti
 
 import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/internal/kv"
 	storetypes "cosmossdk.io/store/types"
 )
 
 // RequireProof returns whether proof is required for the subpath.
 func RequireProof(subpath string) bool {
 	// XXX: create a better convention.
-	// Currently, only when query subpath is "/key", will proof be included in
-	// response. If there are some changes about proof building in iavlstore.go,
-	// we must change code here to keep consistency with iavlStore#Query.
-	return subpath == "/key"
+	// Currently, only when query subpath is "/key" or "/subspace", will proof be
+	// included in response. If there are some changes about proof building in
+	// iavlstore.go, we must change code here to keep consistency with
+	// iavlStore#Query.
+	return subpath == "/key" || subpath == "/subspace"
+}
+
+// VerifyRangeProof verifies that keys and values, sorted by key, are all the
+// entries of the [start, end) range of the store storeName committed in the
+// app hash root. A nil end leaves the range open.
+func VerifyRangeProof(proof *cmtprotocrypto.ProofOps, root []byte, storeName string, start, end []byte, keys, values [][]byte) error {
+	if proof == nil || len(proof.Ops) != 2 {
+		return errorsmod.Wrap(storetypes.ErrInvalidProof, "range proof must hold a store and a multistore proof op")
+	}
+
+	storeOp, err := storetypes.CommitmentOpDecoder(proof.Ops[0])
+	if err != nil {
+		return err
+	}
+	storeRoot, err := storeOp.(storetypes.CommitmentOp).RunRange(start, end, keys, values)
+	if err != nil {
+		return err
+	}
+
+	multiStoreOp, err := storetypes.CommitmentOpDecoder(proof.Ops[1])
+	if err != nil {
+		return err
+	}
+	if string(multiStoreOp.GetKey()) != storeName {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "proof is for store %s, not %s", multiStoreOp.GetKey(), storeName)
+	}
+	appHash, err := multiStoreOp.Run(storeRoot)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(appHash[0], root) {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "calculated root %X doesn't match app hash %X", appHash[0], root)
+	}
+
+	return nil
+}
+
+// VerifySubspaceQuery verifies the proof of a /store/<storeName>/subspace query
+// response against the app hash root, and returns the proven keys and values.
+func VerifySubspaceQuery(res abci.ResponseQuery, root []byte, storeName string) (keys, values [][]byte, err error) {
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(res.Value); err != nil {
+		return nil, nil, err
+	}
+
+	for _, pair := range pairs.Pairs {
+		keys = append(keys, pair.Key)
+		values = append(values, pair.Value)
+	}
+
+	if err := VerifyRangeProof(res.ProofOps, root, storeName, res.Key, storetypes.PrefixEndBytes(res.Key), keys, values); err != nil {
+		return nil, nil, err
+	}
+
+	return keys, values, nil
 }
 
 //-----------------------------------------------------------------------------
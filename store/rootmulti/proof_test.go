@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/confio/ics23/go"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -148,3 +150,86 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreRangeProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	for _, key := range []string{"a1", "a2", "b/1", "b/2", "b/3", "b0", "c", "z1", "z2"} {
+		iavlStore.Set([]byte(key), []byte("v"+key))
+	}
+	cid := store.Commit()
+
+	testCases := []struct {
+		subspace string
+		expected []string
+	}{
+		{"a", []string{"a1", "a2"}},
+		{"b/", []string{"b/1", "b/2", "b/3"}},
+		{"b", []string{"b/1", "b/2", "b/3", "b0"}},
+		{"c", []string{"c"}},
+		{"z", []string{"z1", "z2"}},
+		{"\xff", nil},
+		{"0", nil},
+		{"m", nil},
+	}
+
+	for _, tc := range testCases {
+		res := store.Query(abci.RequestQuery{
+			Path:  "/iavlStoreKey/subspace",
+			Data:  []byte(tc.subspace),
+			Prove: true,
+		})
+		require.True(t, res.IsOK(), res.Log)
+
+		keys, values, err := VerifySubspaceQuery(res, cid.Hash, "iavlStoreKey")
+		require.NoError(t, err, tc.subspace)
+		require.Len(t, values, len(tc.expected))
+		for i, key := range tc.expected {
+			require.Equal(t, []byte(key), keys[i])
+			require.Equal(t, []byte("v"+key), values[i])
+		}
+
+		require.Error(t, VerifyRangeProof(res.ProofOps, cid.Hash, "otherStoreKey", res.Key, types.PrefixEndBytes(res.Key), keys, values))
+		require.Error(t, VerifyRangeProof(res.ProofOps, []byte("apphash"), "iavlStoreKey", res.Key, types.PrefixEndBytes(res.Key), keys, values))
+	}
+
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/subspace",
+		Data:  []byte("b/"),
+		Prove: true,
+	})
+	keys, values, err := VerifySubspaceQuery(res, cid.Hash, "iavlStoreKey")
+	require.NoError(t, err)
+	end := types.PrefixEndBytes(res.Key)
+
+	// omitted and altered entries are detected.
+	require.Error(t, VerifyRangeProof(res.ProofOps, cid.Hash, "iavlStoreKey", res.Key, end, keys[1:], values[1:]))
+	require.Error(t, VerifyRangeProof(res.ProofOps, cid.Hash, "iavlStoreKey", res.Key, end, [][]byte{keys[0], keys[2]}, [][]byte{values[0], values[2]}))
+	require.Error(t, VerifyRangeProof(res.ProofOps, cid.Hash, "iavlStoreKey", res.Key, end, keys, [][]byte{values[0], values[0], values[2]}))
+	// the proof only covers the queried range.
+	require.Error(t, VerifyRangeProof(res.ProofOps, cid.Hash, "iavlStoreKey", []byte("a"), end, keys, values))
+
+	// a proof without the existence proof of a key doesn't prove the range
+	// without it.
+	op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+	require.NoError(t, err)
+	batch := ics23.Decompress(op.(types.CommitmentOp).Proof).GetBatch()
+	entries := make([]*ics23.BatchEntry, 0, len(batch.Entries))
+	for _, entry := range batch.Entries {
+		if exist := entry.GetExist(); exist == nil || string(exist.Key) != "b/2" {
+			entries = append(entries, entry)
+		}
+	}
+	require.Len(t, entries, len(batch.Entries)-1)
+	forged := types.NewIavlCommitmentOp(res.Key, &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Batch{Batch: &ics23.BatchProof{Entries: entries}},
+	})
+	proof := &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{forged.ProofOp(), res.ProofOps.Ops[1]}}
+	require.Error(t, VerifyRangeProof(proof, cid.Hash, "iavlStoreKey", res.Key, end, [][]byte{keys[0], keys[2]}, [][]byte{values[0], values[2]}))
+}
@@ -0,0 +1,293 @@
+package rootmulti
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	iavltree "github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// asyncPruneHeightsKey persists the heights waiting to be pruned by the
+// background pruner, so that pruning resumes after a crash or a restart.
+var asyncPruneHeightsKey = []byte("s/asyncpruneheights")
+
+// asyncPruner prunes the heights handed over by Commit on a background
+// goroutine, one height at a time and at most one height per interval.
+type asyncPruner struct {
+	rs       *Store
+	interval time.Duration
+
+	mtx     sync.Mutex
+	pending []int64
+
+	wake    chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	started bool
+}
+
+func newAsyncPruner(rs *Store, heightsPerSecond uint64) *asyncPruner {
+	var interval time.Duration
+	if heightsPerSecond > 0 {
+		interval = time.Second / time.Duration(heightsPerSecond)
+	}
+
+	return &asyncPruner{
+		rs:       rs,
+		interval: interval,
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// start loads the heights persisted by a previous run and starts the worker.
+// It is a no-op if the worker is already started.
+func (p *asyncPruner) start() error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.started {
+		return nil
+	}
+
+	bz, err := p.rs.db.Get(asyncPruneHeightsKey)
+	if err != nil {
+		return fmt.Errorf("failed to get async pruning heights: %w", err)
+	}
+
+	pending, err := bytesToHeights(bz)
+	if err != nil {
+		return err
+	}
+
+	p.pending = mergeHeights(pending, p.pending)
+	p.started = true
+
+	go p.run()
+	p.notify()
+
+	return nil
+}
+
+// enqueue persists the given heights and hands them over to the worker.
+func (p *asyncPruner) enqueue(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	pending := mergeHeights(p.pending, heights)
+	if err := p.rs.db.SetSync(asyncPruneHeightsKey, heightsToBytes(pending)); err != nil {
+		return err
+	}
+	p.pending = pending
+	p.notify()
+
+	return nil
+}
+
+// pendingHeights returns a copy of the heights waiting to be pruned.
+func (p *asyncPruner) pendingHeights() []int64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return append([]int64{}, p.pending...)
+}
+
+// close stops the worker, waiting for the height being pruned if any.
+func (p *asyncPruner) close() {
+	p.mtx.Lock()
+	started := p.started
+	p.mtx.Unlock()
+
+	if !started {
+		return
+	}
+
+	close(p.stop)
+	<-p.done
+}
+
+func (p *asyncPruner) notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *asyncPruner) run() {
+	defer close(p.done)
+
+	for {
+		height, ok := p.next()
+		if !ok {
+			select {
+			case <-p.wake:
+				continue
+			case <-p.stop:
+				return
+			}
+		}
+
+		wait := p.interval
+		if err := p.rs.pruneVersion(height); err != nil {
+			// retry later, the height stays persisted in the meantime.
+			p.rs.logger.Error("failed to prune height", "height", height, "err", err)
+			wait = time.Second
+		} else if err := p.pruned(height); err != nil {
+			p.rs.logger.Error("failed to persist async pruning progress", "height", height, "err", err)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+func (p *asyncPruner) next() (int64, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if len(p.pending) == 0 {
+		return 0, false
+	}
+
+	return p.pending[0], true
+}
+
+// pruned removes height from the pending heights and persists the progress.
+func (p *asyncPruner) pruned(height int64) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	pending := make([]int64, 0, len(p.pending))
+	for _, h := range p.pending {
+		if h != height {
+			pending = append(pending, h)
+		}
+	}
+	p.pending = pending
+
+	return p.rs.db.SetSync(asyncPruneHeightsKey, heightsToBytes(pending))
+}
+
+// pruneVersion deletes the given version of every IAVL store. Each deletion
+// holds the versions lock, so that it never runs concurrently with Commit or
+// with the loading of a past version by CacheMultiStoreWithVersion, while
+// Commit waits at most for the deletion of one version of one store.
+// Versions which are already deleted, e.g. when resuming after a crash, are
+// skipped.
+func (rs *Store) pruneVersion(version int64) error {
+	rs.versionsMtx.RLock()
+	stores := make([]*iavl.Store, 0, len(rs.stores))
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		if rs.stores[key].GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		if store, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok {
+			stores = append(stores, store)
+		}
+	}
+	rs.versionsMtx.RUnlock()
+
+	for _, store := range stores {
+		rs.versionsMtx.Lock()
+		err := store.DeleteVersions(version)
+		rs.versionsMtx.Unlock()
+
+		if err != nil && !errors.Is(err, iavltree.ErrVersionDoesNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockedCacheMultiStore is a cache multistore of a Store pruned in the
+// background. Its writes to the IAVL stores hold the versions lock, so that
+// they never run concurrently with the deletion of a version of these stores
+// by the pruner.
+type lockedCacheMultiStore struct {
+	cachemulti.Store
+	mtx *sync.RWMutex
+}
+
+var _ types.CacheMultiStore = lockedCacheMultiStore{}
+
+// Write implements CacheMultiStore.
+func (cms lockedCacheMultiStore) Write() {
+	cms.mtx.Lock()
+	defer cms.mtx.Unlock()
+
+	cms.Store.Write()
+}
+
+// SetTracer implements MultiStore.
+func (cms lockedCacheMultiStore) SetTracer(w io.Writer) types.MultiStore {
+	cms.Store = cms.Store.SetTracer(w).(cachemulti.Store)
+	return cms
+}
+
+// SetTracingContext implements MultiStore.
+func (cms lockedCacheMultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	cms.Store = cms.Store.SetTracingContext(tc).(cachemulti.Store)
+	return cms
+}
+
+// mergeHeights returns the sorted union of a and b.
+func mergeHeights(a, b []int64) []int64 {
+	seen := make(map[int64]struct{}, len(a)+len(b))
+	merged := make([]int64, 0, len(a)+len(b))
+	for _, heights := range [][]int64{a, b} {
+		for _, h := range heights {
+			if _, ok := seen[h]; ok {
+				continue
+			}
+			seen[h] = struct{}{}
+			merged = append(merged, h)
+		}
+	}
+
+	sort.Slice(merged, func(i, j int) bool { return merged[i] < merged[j] })
+	return merged
+}
+
+func heightsToBytes(heights []int64) []byte {
+	bz := make([]byte, 8*len(heights))
+	for i, h := range heights {
+		binary.BigEndian.PutUint64(bz[8*i:], uint64(h))
+	}
+
+	return bz
+}
+
+func bytesToHeights(bz []byte) ([]int64, error) {
+	if len(bz)%8 != 0 {
+		return nil, fmt.Errorf("invalid async pruning heights length %d", len(bz))
+	}
+
+	heights := make([]int64, len(bz)/8)
+	for i := range heights {
+		heights[i] = int64(binary.BigEndian.Uint64(bz[8*i:]))
+		if heights[i] < 0 {
+			return nil, fmt.Errorf("invalid async pruning height %d", heights[i])
+		}
+	}
+
+	return heights, nil
+}
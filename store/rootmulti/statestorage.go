@@ -0,0 +1,247 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
+)
+
+// ssStore is the KVStore handed out for an IAVL store when the state storage
+// is enabled. Writes go to both the IAVL tree, the state commitment, and to a
+// change set flushed to the state storage on Commit. Reads are served by the
+// state storage at the last committed version, except between a write and
+// the next Commit, where only the IAVL working tree holds the pending writes.
+type ssStore struct {
+	types.CommitKVStore
+
+	ss   *versiondb.Store
+	name string
+
+	mtx     sync.RWMutex
+	version int64
+	changes map[string]*types.StoreKVPair
+}
+
+var _ types.KVStore = (*ssStore)(nil)
+
+func newSSStore(ss *versiondb.Store, name string, store types.CommitKVStore, version int64) *ssStore {
+	return &ssStore{
+		CommitKVStore: store,
+		ss:            ss,
+		name:          name,
+		version:       version,
+		changes:       make(map[string]*types.StoreKVPair),
+	}
+}
+
+// reader returns the store serving reads.
+func (s *ssStore) reader() types.KVStore {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if len(s.changes) > 0 {
+		return s.CommitKVStore
+	}
+
+	return s.ss.KVStore(s.name, s.version)
+}
+
+// Get implements KVStore.
+func (s *ssStore) Get(key []byte) []byte {
+	return s.reader().Get(key)
+}
+
+// Has implements KVStore.
+func (s *ssStore) Has(key []byte) bool {
+	return s.reader().Has(key)
+}
+
+// Iterator implements KVStore.
+func (s *ssStore) Iterator(start, end []byte) types.Iterator {
+	return s.reader().Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *ssStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.reader().ReverseIterator(start, end)
+}
+
+// Set implements KVStore.
+func (s *ssStore) Set(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.CommitKVStore.Set(key, value)
+	s.changes[string(key)] = &types.StoreKVPair{
+		StoreKey: s.name,
+		Key:      append([]byte{}, key...),
+		Value:    append([]byte{}, value...),
+	}
+}
+
+// Delete implements KVStore.
+func (s *ssStore) Delete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.CommitKVStore.Delete(key)
+	s.changes[string(key)] = &types.StoreKVPair{
+		StoreKey: s.name,
+		Delete:   true,
+		Key:      append([]byte{}, key...),
+	}
+}
+
+// CacheWrap implements Store.
+func (s *ssStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements Store.
+func (s *ssStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// popChanges returns the pending changes sorted by key.
+func (s *ssStore) popChanges() []*types.StoreKVPair {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	changes := make([]*types.StoreKVPair, 0, len(s.changes))
+	for _, pair := range s.changes {
+		changes = append(changes, pair)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return string(changes[i].Key) < string(changes[j].Key)
+	})
+
+	return changes
+}
+
+// committed makes the store serve reads from the given version, once the
+// pending changes are written to the state storage.
+func (s *ssStore) committed(version int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.version = version
+	s.changes = make(map[string]*types.StoreKVPair)
+}
+
+// SetStateStorage enables the separation of the state storage (SS) from the
+// state commitment (SC). The IAVL stores keep committing the state, while every
+// write is also recorded in a flat versioned key-value layout in db, which
+// serves the reads and the loading of past versions. As the state storage
+// keeps the full history, the IAVL stores can then be pruned aggressively.
+// When enabled on an existing chain, the state at the loaded version is
+// imported and past versions keep being loaded from the IAVL stores.
+// It must be called before loading the store.
+func (rs *Store) SetStateStorage(db dbm.DB) {
+	rs.ss = versiondb.NewStore(db)
+}
+
+// needsStateStorageImport returns true if the state at version must be
+// imported into the state storage, which is the case if it is empty while
+// version is not.
+func (rs *Store) needsStateStorageImport(version int64) (bool, error) {
+	if rs.ss == nil || version == 0 {
+		return false, nil
+	}
+
+	latest, err := rs.ss.LatestVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return latest == 0, nil
+}
+
+// importStateStorage imports the state of the store of key at version into
+// the state storage, if it is an IAVL store.
+func (rs *Store) importStateStorage(key types.StoreKey, store types.CommitKVStore, version int64) error {
+	if rs.interBlockCache != nil {
+		if unwrapped := rs.interBlockCache.Unwrap(key); unwrapped != nil {
+			store = unwrapped
+		}
+	}
+
+	iavlStore, ok := store.(*iavl.Store)
+	if !ok {
+		return nil
+	}
+
+	immutable, err := iavlStore.GetImmutable(version)
+	if err != nil {
+		return err
+	}
+
+	if err := rs.ss.Import(version, key.Name(), immutable.Iterator(nil, nil)); err != nil {
+		return fmt.Errorf("failed to import store %s into state storage: %w", key.Name(), err)
+	}
+
+	return nil
+}
+
+// loadStateStorage completes the import of the state at version into the
+// state storage if imported is true, and checks that the state storage is not
+// behind the loaded version otherwise. The state storage may be ahead, e.g.
+// when loading a past version to export it, or when a commit was interrupted
+// between the state storage and the metadata.
+func (rs *Store) loadStateStorage(version int64, imported bool) error {
+	if imported {
+		return rs.ss.WriteChangeSet(version, nil)
+	}
+
+	latest, err := rs.ss.LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if latest < version {
+		return fmt.Errorf("state storage is at version %d, behind the loaded version %d", latest, version)
+	}
+
+	return nil
+}
+
+// flushStateStorage writes the changes of the IAVL stores to the state storage.
+func (rs *Store) flushStateStorage(version int64) error {
+	var changes []*types.StoreKVPair
+	for _, key := range keysFromStoreKeyMap(rs.ssStores) {
+		changes = append(changes, rs.ssStores[key].popChanges()...)
+	}
+
+	if err := rs.ss.WriteChangeSet(version, changes); err != nil {
+		return err
+	}
+
+	for _, store := range rs.ssStores {
+		store.committed(version)
+	}
+
+	return nil
+}
+
+// cacheStoreWithVersion returns the state storage view of an IAVL store at a
+// past version, or nil if the state storage doesn't hold that version.
+func (rs *Store) cacheStoreWithVersion(key types.StoreKey, version int64) (types.KVStore, error) {
+	if rs.ss == nil || version > rs.LastCommitID().Version {
+		return nil, nil
+	}
+
+	earliest, err := rs.ss.EarliestVersion()
+	if err != nil || earliest == 0 || version < earliest {
+		return nil, err
+	}
+
+	return rs.ss.KVStore(key.Name(), version), nil
+}
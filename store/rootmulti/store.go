@@ -22,6 +22,7 @@ import (
 	"cosmossdk.io/store/iavl"
 	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/mem"
+	"cosmossdk.io/store/metrickv"
 	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
@@ -29,6 +30,7 @@ import (
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/transient"
 	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 )
 
 const (
@@ -56,6 +58,7 @@ func keysFromStoreKeyMap[V any](m map[types.StoreKey]V) []types.StoreKey {
 // the CommitMultiStore interface.
 type Store struct {
 	db                  dbm.DB
+	commitDB            *commitDB
 	logger              log.Logger
 	lastCommitInfo      *types.CommitInfo
 	pruningManager      *pruning.Manager
@@ -77,6 +80,16 @@ type Store struct {
 	listeners map[types.StoreKey]*types.MemoryListener
 
 	metrics metrics.StoreMetrics
+
+	// versionsMtx serializes the creation and deletion of IAVL versions, and
+	// guards the loading of past versions against concurrent pruning. When the
+	// store is pruned in the background, the writes of its cache multistores to
+	// the IAVL stores also hold it, see lockedCacheMultiStore.
+	versionsMtx sync.RWMutex
+	asyncPruner *asyncPruner
+
+	ss       *versiondb.Store
+	ssStores map[types.StoreKey]*ssStore
 }
 
 var (
@@ -91,6 +104,7 @@ var (
 func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics) *Store {
 	return &Store{
 		db:                  db,
+		commitDB:            newCommitDB(db),
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
 		iavlDisableFastNode: iavlDisablefastNodeDefault,
@@ -98,6 +112,7 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:          make(map[string]types.StoreKey),
 		listeners:           make(map[types.StoreKey]*types.MemoryListener),
+		ssStores:            make(map[types.StoreKey]*ssStore),
 		removalMap:          make(map[types.StoreKey]bool),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
@@ -127,6 +142,24 @@ func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
 }
 
+// SetAsyncPruning makes the store prune the heights of its pruning strategy on
+// a background worker instead of during Commit. At most heightsPerSecond heights
+// are pruned per second, 0 meaning no limit. The heights waiting to be pruned
+// are persisted, so that pruning resumes when the store is loaded again.
+// It must be called before loading the store.
+func (rs *Store) SetAsyncPruning(heightsPerSecond uint64) {
+	rs.asyncPruner = newAsyncPruner(rs, heightsPerSecond)
+}
+
+// StopAsyncPruning stops the background pruning worker, waiting for the height
+// being pruned if any. The remaining heights are pruned when the store is
+// loaded again.
+func (rs *Store) StopAsyncPruning() {
+	if rs.asyncPruner != nil {
+		rs.asyncPruner.close()
+	}
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -230,6 +263,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 	// load each Store (note this doesn't panic on unmounted keys now)
 	newStores := make(map[types.StoreKey]types.CommitKVStore)
+	newSSStores := make(map[types.StoreKey]*ssStore)
 
 	storesKeys := make([]types.StoreKey, 0, len(rs.storesParams))
 
@@ -246,6 +280,28 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		})
 	}
 
+	// a commit interrupted after some stores were committed leaves them at a
+	// version after the latest version, which is then deleted.
+	repair := ver != 0 && ver == GetLatestVersion(rs.db)
+	journal, err := rs.db.Get(commitJournalKey)
+	if err != nil {
+		return err
+	}
+	if journal != nil {
+		rs.logger.Info("detected interrupted commit", "version", ver+1)
+	}
+
+	// when the state storage is enabled on an existing chain, the state is
+	// imported before the upgrades are applied, as they read it through the
+	// state storage.
+	importSS, err := rs.needsStateStorageImport(ver)
+	if err != nil {
+		return err
+	}
+	if importSS {
+		rs.logger.Info("importing state into state storage", "version", ver)
+	}
+
 	for _, key := range storesKeys {
 		storeParams := rs.storesParams[key]
 		commitID := rs.getCommitID(infos, key.Name())
@@ -263,11 +319,30 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			return errorsmod.Wrap(err, "failed to load store")
 		}
 
+		if repair && !upgrades.IsAdded(key.Name()) && upgrades.RenamedFrom(key.Name()) == "" {
+			if err := rs.repairStore(key, store, ver); err != nil {
+				return errorsmod.Wrapf(err, "failed to repair store %s", key.Name())
+			}
+		}
+
 		newStores[key] = store
 
+		if _, ok := infos[key.Name()]; ok && importSS {
+			if err := rs.importStateStorage(key, store, ver); err != nil {
+				return err
+			}
+		}
+
+		// upgrades write through the state storage, so that it records them
+		kvStore := types.KVStore(store)
+		if rs.ss != nil && storeParams.typ == types.StoreTypeIAVL {
+			newSSStores[key] = newSSStore(rs.ss, key.Name(), store, ver)
+			kvStore = newSSStores[key]
+		}
+
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
-			if err := deleteKVStore(store.(types.KVStore)); err != nil {
+			if err := deleteKVStore(kvStore); err != nil {
 				return errorsmod.Wrapf(err, "failed to delete store %s", key.Name())
 			}
 			rs.removalMap[key] = true
@@ -283,8 +358,20 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 				return errorsmod.Wrapf(err, "failed to load old store %s", oldName)
 			}
 
+			if _, ok := infos[oldName]; ok && importSS {
+				if err := rs.importStateStorage(oldKey, oldStore, ver); err != nil {
+					return err
+				}
+			}
+
+			oldKVStore := types.KVStore(oldStore)
+			if rs.ss != nil && storeParams.typ == types.StoreTypeIAVL {
+				newSSStores[oldKey] = newSSStore(rs.ss, oldName, oldStore, ver)
+				oldKVStore = newSSStores[oldKey]
+			}
+
 			// move all data
-			if err := moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore)); err != nil {
+			if err := moveKVStoreData(oldKVStore, kvStore); err != nil {
 				return errorsmod.Wrapf(err, "failed to move store %s -> %s", oldName, key.Name())
 			}
 
@@ -295,14 +382,33 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	if journal != nil {
+		if err := rs.db.DeleteSync(commitJournalKey); err != nil {
+			return err
+		}
+	}
+
+	rs.versionsMtx.Lock()
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
+	rs.ssStores = newSSStores
+	rs.versionsMtx.Unlock()
+
+	if rs.ss != nil {
+		if err := rs.loadStateStorage(ver, importSS); err != nil {
+			return err
+		}
+	}
 
 	// load any pruned heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadPruningHeights(rs.db); err != nil {
 		return err
 	}
 
+	if rs.asyncPruner != nil {
+		return rs.asyncPruner.start()
+	}
+
 	return nil
 }
 
@@ -469,19 +575,38 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.versionsMtx.Lock()
+
+	// The IAVL stores with their own database can't be committed atomically
+	// with the commit info: the version is journaled first, so that loadVersion
+	// repairs the stores if the commit is interrupted.
+	if rs.hasOwnDBStores() {
+		flushCommitJournal(rs.db, version)
+	}
+
+	rs.commitDB.begin()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
-	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+
+	if rs.ss != nil {
+		if err := rs.flushStateStorage(version); err != nil {
+			panic(fmt.Errorf("failed to write state storage: %w", err))
+		}
+	}
+
+	rs.flushCommit(version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
 		if _, ok := rs.stores[sk]; ok {
 			delete(rs.stores, sk)
+			delete(rs.ssStores, sk)
 			delete(rs.storesParams, sk)
 			delete(rs.keysByName, sk.Name())
 		}
 	}
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
+	rs.versionsMtx.Unlock()
 
 	if err := rs.handlePruning(version); err != nil {
 		panic(err)
@@ -509,14 +634,22 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
 		store := types.KVStore(v)
+		if s, ok := rs.ssStores[k]; ok {
+			store = s
+		}
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(k) {
 			store = listenkv.NewStore(store, k, rs.listeners[k])
 		}
-		stores[k] = store
+		stores[k] = rs.withMetrics(k, store)
+	}
+	cms := cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
+	if rs.asyncPruner != nil {
+		return lockedCacheMultiStore{Store: cms, mtx: &rs.versionsMtx}
 	}
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
+
+	return cms
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -524,18 +657,31 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	// the version must not be pruned while its stores are being loaded.
+	rs.versionsMtx.RLock()
+	defer rs.versionsMtx.RUnlock()
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
+			// Serve the version from the state storage if it holds it.
+			var err error
+			cacheStore, err = rs.cacheStoreWithVersion(key, version)
+			if err != nil {
+				return nil, err
+			}
+			if cacheStore != nil {
+				break
+			}
+
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
-			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
 			if err != nil {
 				return nil, err
@@ -550,7 +696,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
 		}
 
-		cachedStores[key] = cacheStore
+		cachedStores[key] = rs.withMetrics(key, cacheStore)
 	}
 
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
@@ -583,6 +729,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
 	store := types.KVStore(s)
+	if ss, ok := rs.ssStores[key]; ok {
+		store = ss
+	}
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
@@ -591,7 +740,17 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 		store = listenkv.NewStore(store, key, rs.listeners[key])
 	}
 
-	return store
+	return rs.withMetrics(key, store)
+}
+
+// withMetrics wraps store with a metrickv.Store reporting its metrics under
+// key, unless the metrics are disabled.
+func (rs *Store) withMetrics(key types.StoreKey, store types.KVStore) types.KVStore {
+	if _, noop := rs.metrics.(metrics.NoOpMetrics); noop || rs.metrics == nil {
+		return store
+	}
+
+	return metrickv.NewStore(store, key, rs.metrics)
 }
 
 func (rs *Store) handlePruning(version int64) error {
@@ -599,6 +758,17 @@ func (rs *Store) handlePruning(version int64) error {
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
+
+	if rs.asyncPruner != nil {
+		heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+		if err != nil {
+			return err
+		}
+
+		rs.logger.Debug("async prune scheduled", "height", version, "heights", heights)
+		return rs.asyncPruner.enqueue(heights)
+	}
+
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
 	return rs.PruneStores(true, nil)
@@ -941,16 +1111,32 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+// StoreDB returns the database holding the data of the store of key, i.e. the
+// database the store was mounted with or the database of the Store, prefixed
+// for the store. It returns nil if the store is not mounted.
+func (rs *Store) StoreDB(key types.StoreKey) dbm.DB {
+	params, ok := rs.storesParams[key]
+	if !ok {
+		return nil
+	}
+
+	return rs.storeDB(params)
+}
 
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	// the stores sharing the database of the Store are committed atomically
+	// with the commit info.
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.commitDB, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -1043,6 +1229,12 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		}
 	}
 
+	if rs.ss != nil {
+		if err := rs.ss.Rollback(target); err != nil {
+			return err
+		}
+	}
+
 	rs.flushMetadata(rs.db, target, rs.buildCommitInfo(target))
 
 	return rs.LoadLatestVersion()
@@ -1067,6 +1259,25 @@ func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo
 	rs.logger.Debug("flushing metadata finished", "height", version)
 }
 
+// flushCommit writes the changes of the stores sharing the database of the
+// Store, gathered by commitDB, atomically with the commit info of the version.
+func (rs *Store) flushCommit(version int64, cInfo *types.CommitInfo) {
+	rs.logger.Debug("flushing commit", "height", version)
+	batch := rs.commitDB.end()
+	defer batch.Close()
+
+	flushCommitInfo(batch, version, cInfo)
+	flushLatestVersion(batch, version)
+	if err := batch.Delete(commitJournalKey); err != nil {
+		panic(err)
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		panic(fmt.Errorf("error on batch write %w", err))
+	}
+	rs.logger.Debug("flushing commit finished", "height", version)
+}
+
 type storeParams struct {
 	key            types.StoreKey
 	db             dbm.DB
@@ -1172,6 +1383,17 @@ func flushCommitInfo(batch dbm.Batch, version int64, cInfo *types.CommitInfo) {
 	batch.Set([]byte(cInfoKey), bz)
 }
 
+func flushCommitJournal(db dbm.DB, version int64) {
+	bz, err := gogotypes.StdInt64Marshal(version)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := db.SetSync(commitJournalKey, bz); err != nil {
+		panic(err)
+	}
+}
+
 func flushLatestVersion(batch dbm.Batch, version int64) {
 	bz, err := gogotypes.StdInt64Marshal(version)
 	if err != nil {
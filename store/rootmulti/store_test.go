@@ -14,9 +14,11 @@ import (
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/iavl"
 	sdkmaps "cosmossdk.io/store/internal/maps"
+	"cosmossdk.io/store/metrickv"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 )
 
 func TestStoreType(t *testing.T) {
@@ -42,6 +44,38 @@ func TestGetCommitKVStore(t *testing.T) {
 	require.IsType(t, &iavl.Store{}, store2)
 }
 
+func TestStoreDB(t *testing.T) {
+	db, ownDB := dbm.NewMemDB(), dbm.NewMemDB()
+	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+
+	key1 := types.NewKVStoreKey("store1")
+	key2 := types.NewKVStoreKey("store2")
+	store.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(key2, types.StoreTypeIAVL, ownDB)
+	require.NoError(t, store.LoadLatestVersion())
+
+	store.GetKVStore(key1).Set([]byte("key"), []byte("value"))
+	store.GetKVStore(key2).Set([]byte("key"), []byte("value"))
+	store.Commit()
+
+	// the data of the stores is found under their prefix in their database.
+	for prefix, db := range map[string]dbm.DB{"s/k:store1/": db, "s/_/": ownDB} {
+		it, err := dbm.IteratePrefix(db, []byte(prefix))
+		require.NoError(t, err)
+		require.True(t, it.Valid())
+		require.NoError(t, it.Close())
+	}
+
+	for _, key := range []types.StoreKey{key1, key2} {
+		it, err := store.StoreDB(key).Iterator(nil, nil)
+		require.NoError(t, err)
+		require.True(t, it.Valid())
+		require.NoError(t, it.Close())
+	}
+
+	require.Nil(t, store.StoreDB(types.NewKVStoreKey("store3")))
+}
+
 func TestStoreMount(t *testing.T) {
 	db := dbm.NewMemDB()
 	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
@@ -511,6 +545,336 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_AsyncPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetAsyncPruning(0)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.StopAsyncPruning()
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	require.Eventually(t, func() bool {
+		return len(ms.asyncPruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for v := int64(1); v < 8; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	for v := int64(8); v <= 10; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+}
+
+func TestMultiStore_AsyncPruning_ConcurrentWrites(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetAsyncPruning(0)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.StopAsyncPruning()
+
+	// a write to the IAVL stores waits for the deletion of a version in
+	// progress.
+	cms := ms.CacheMultiStore()
+	require.IsType(t, lockedCacheMultiStore{}, cms)
+	cms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value"))
+
+	ms.versionsMtx.Lock()
+	written := make(chan struct{})
+	go func() {
+		cms.Write()
+		close(written)
+	}()
+
+	select {
+	case <-written:
+		t.Fatal("write did not wait for the versions lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ms.versionsMtx.Unlock()
+	<-written
+	ms.Commit()
+
+	// the writes of each block run while the previous heights are pruned in
+	// the background.
+	for i := 0; i < 50; i++ {
+		cms := ms.CacheMultiStore()
+		for j := 0; j < 20; j++ {
+			key := []byte(fmt.Sprintf("key-%d", j))
+			cms.GetKVStore(testStoreKey1).Set(key, []byte(fmt.Sprintf("value-%d", i)))
+			cms.GetKVStore(testStoreKey2).Delete(key)
+		}
+		cms.Write()
+		ms.Commit()
+	}
+
+	require.Eventually(t, func() bool {
+		return len(ms.asyncPruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []byte("value-49"), ms.GetKVStore(testStoreKey1).Get([]byte("key-0")))
+	_, err := ms.CacheMultiStoreWithVersion(47)
+	require.Error(t, err)
+}
+
+func TestMultiStore_AsyncPruning_Resume(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetAsyncPruning(0)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// stop the worker as if the node crashed: the heights are only persisted.
+	ms.StopAsyncPruning()
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, ms.asyncPruner.pendingHeights())
+	for v := int64(1); v <= 10; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	// pruning resumes when the store is loaded again.
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetAsyncPruning(1000)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.StopAsyncPruning()
+
+	require.Eventually(t, func() bool {
+		return len(ms.asyncPruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for v := int64(1); v < 8; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	bz, err := db.Get(asyncPruneHeightsKey)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+}
+
+func TestMultiStore_StateStorage(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetStateStorage(dbm.NewPrefixDB(db, []byte("ss/")))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v1, v2 := []byte("key"), []byte("v1"), []byte("v2")
+
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(testStoreKey1).Set(k, v1)
+	cms.Write()
+
+	// pending writes are read from the IAVL working tree until committed.
+	require.Equal(t, v1, ms.GetKVStore(testStoreKey1).Get(k))
+	ms.Commit()
+	require.IsType(t, &ssStore{}, ms.GetKVStore(testStoreKey1))
+	require.Equal(t, v1, ms.GetKVStore(testStoreKey1).Get(k))
+
+	for i := 0; i < 20; i++ {
+		cms = ms.CacheMultiStore()
+		if i == 10 {
+			cms.GetKVStore(testStoreKey1).Set(k, v2)
+			cms.GetKVStore(testStoreKey2).Set(k, v2)
+		}
+		if i == 19 {
+			cms.GetKVStore(testStoreKey3).Set(k, v2)
+		}
+		cms.Write()
+		ms.Commit()
+	}
+
+	// the IAVL versions are pruned, but every version is served by the state storage.
+	_, err := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).GetImmutable(2)
+	require.Error(t, err)
+
+	for version := int64(1); version <= ms.LatestVersion(); version++ {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+
+		expected := v1
+		if version > 11 {
+			expected = v2
+		}
+		require.Equal(t, expected, cms.GetKVStore(testStoreKey1).Get(k), "version %d", version)
+		require.Equal(t, version > 11, cms.GetKVStore(testStoreKey2).Has(k), "version %d", version)
+	}
+
+	_, err = ms.CacheMultiStoreWithVersion(ms.LatestVersion() + 1)
+	require.Error(t, err)
+
+	// the state storage is resumed on restart.
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetStateStorage(dbm.NewPrefixDB(db, []byte("ss/")))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, v2, ms.GetKVStore(testStoreKey2).Get(k))
+
+	cms, err = ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, v1, cms.GetKVStore(testStoreKey1).Get(k))
+
+	// the rolled back versions are removed from the state storage.
+	require.True(t, ms.GetKVStore(testStoreKey3).Has(k))
+	require.NoError(t, ms.RollbackToVersion(ms.LatestVersion()-1))
+	require.False(t, ms.GetKVStore(testStoreKey3).Has(k))
+
+	value, err := ms.ss.Get(testStoreKey3.Name(), 21, k)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestMultiStore_StateStorage_Import(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("key"), []byte("value")
+	ms.GetKVStore(testStoreKey1).Set(k, v)
+	ms.Commit()
+	ms.Commit()
+
+	// enabling the state storage on an existing chain imports the latest state.
+	ssDB := dbm.NewMemDB()
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ssDB)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, v, ms.GetKVStore(testStoreKey1).Get(k))
+
+	earliest, err := ms.ss.EarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), earliest)
+
+	// older versions are still loaded from the IAVL stores, the state storage
+	// doesn't hold them.
+	cms, err := ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, v, cms.GetKVStore(testStoreKey1).Get(k))
+	require.Nil(t, ms.ss.KVStore(testStoreKey1.Name(), 1).Get(k))
+
+	ms.GetKVStore(testStoreKey1).Delete(k)
+	ms.Commit()
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ssDB)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.False(t, ms.GetKVStore(testStoreKey1).Has(k))
+
+	// a state storage behind the multistore is rejected.
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ssDB)
+	require.Error(t, ms.LoadLatestVersion())
+}
+
+func TestMultiStore_StateStorage_Upgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	ssDB := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ssDB)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("key"), []byte("value")
+	ms.GetKVStore(testStoreKey2).Set(k, v)
+	ms.GetKVStore(testStoreKey3).Set(k, v)
+	ms.Commit()
+
+	// renamed and deleted stores are recorded by the state storage.
+	restore, upgrades := newMultiStoreWithModifiedMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	restore.SetStateStorage(ssDB)
+	require.NoError(t, restore.LoadLatestVersionAndUpgrade(upgrades))
+	restore.Commit()
+
+	require.Equal(t, v, restore.GetKVStore(restore.keysByName["restore2"]).Get(k))
+
+	ss := versiondb.NewStore(ssDB)
+	for _, name := range []string{"store2", "store3"} {
+		value, err := ss.Get(name, 1, k)
+		require.NoError(t, err)
+		require.Equal(t, v, value)
+
+		value, err = ss.Get(name, 2, k)
+		require.NoError(t, err)
+		require.Nil(t, value)
+	}
+}
+
+func TestMultiStore_StateStorage_ImportAndUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("key"), []byte("value")
+	ms.GetKVStore(testStoreKey1).Set(k, v)
+	ms.GetKVStore(testStoreKey2).Set(k, v)
+	ms.GetKVStore(testStoreKey3).Set(k, v)
+	ms.Commit()
+
+	// the state storage is enabled in the same load as the upgrades, which
+	// apply to the imported state.
+	ssDB := dbm.NewMemDB()
+	restore, upgrades := newMultiStoreWithModifiedMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	restore.SetStateStorage(ssDB)
+	require.NoError(t, restore.LoadLatestVersionAndUpgrade(upgrades))
+	restore.Commit()
+
+	require.Equal(t, v, restore.GetStoreByName("store1").(types.KVStore).Get(k))
+	require.Equal(t, v, restore.GetStoreByName("restore2").(types.KVStore).Get(k))
+	require.Nil(t, restore.GetStoreByName("store3"))
+
+	ss := versiondb.NewStore(ssDB)
+	for name, values := range map[string][][]byte{
+		"store1":   {v, v},
+		"store2":   {v, nil},
+		"restore2": {nil, v},
+		"store3":   {v, nil},
+	} {
+		for i, want := range values {
+			value, err := ss.Get(name, int64(i+1), k)
+			require.NoError(t, err)
+			require.Equal(t, want, value, "store %s at version %d", name, i+1)
+		}
+	}
+
+	// the upgraded state commitment matches the one of an upgrade without
+	// state storage.
+	plain := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, plain.LoadLatestVersion())
+	plain.GetKVStore(testStoreKey1).Set(k, v)
+	plain.GetKVStore(testStoreKey2).Set(k, v)
+	plain.GetKVStore(testStoreKey3).Set(k, v)
+	plain.Commit()
+	plainDB := plain.db
+	plain, upgrades = newMultiStoreWithModifiedMounts(plainDB, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, plain.LoadLatestVersionAndUpgrade(upgrades))
+	require.Equal(t, plain.Commit().Hash, restore.LastCommitID().Hash)
+}
+
+func TestMultiStore_Metrics(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	_, ok := ms.GetKVStore(testStoreKey1).(*metrickv.Store)
+	require.False(t, ok)
+
+	ms.SetMetrics(metrics.NewMetrics(nil))
+	require.IsType(t, &metrickv.Store{}, ms.GetKVStore(testStoreKey1))
+
+	cms := ms.CacheMultiStore()
+	storeKey, m := cms.GetKVStore(testStoreKey1).(metrics.Reporter).StoreMetrics()
+	require.Equal(t, testStoreKey1.Name(), storeKey)
+	require.NotNil(t, m)
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -971,3 +1335,121 @@ func TestCommitStores(t *testing.T) {
 		})
 	}
 }
+
+type batchCountingDB struct {
+	dbm.DB
+	writes int
+}
+
+func (db *batchCountingDB) NewBatch() dbm.Batch {
+	return &countingBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+type countingBatch struct {
+	dbm.Batch
+	db *batchCountingDB
+}
+
+func (b *countingBatch) Write() error {
+	b.db.writes++
+	return b.Batch.Write()
+}
+
+func (b *countingBatch) WriteSync() error {
+	b.db.writes++
+	return b.Batch.WriteSync()
+}
+
+func TestCommitAtomic(t *testing.T) {
+	db := &batchCountingDB{DB: dbm.NewMemDB()}
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("2"))
+	ms.GetKVStore(testStoreKey3).Set([]byte("c"), []byte("3"))
+
+	// the three stores and the commit info are written in one batch.
+	db.writes = 0
+	commitID := ms.Commit()
+	require.Equal(t, 1, db.writes)
+
+	has, err := db.Has(commitJournalKey)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	reloaded := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, commitID, reloaded.LastCommitID())
+	require.Equal(t, []byte("2"), reloaded.GetKVStore(testStoreKey2).Get([]byte("b")))
+}
+
+func TestCommitRepair(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	ms.Commit()
+
+	// a commit interrupted after the first store was committed.
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("2"))
+	ms.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("2"))
+	ms.GetCommitKVStore(testStoreKey1).Commit()
+
+	reloaded := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, int64(1), reloaded.LastCommitID().Version)
+	require.Equal(t, []int{1}, reloaded.GetCommitKVStore(testStoreKey1).(*iavl.Store).GetAllVersions())
+	require.Equal(t, []byte("1"), reloaded.GetKVStore(testStoreKey1).Get([]byte("a")))
+
+	// the block is replayed to the same state as an uninterrupted commit.
+	expected := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, expected.LoadLatestVersion())
+	for _, s := range []*Store{reloaded, expected} {
+		if s == expected {
+			s.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+			s.Commit()
+		}
+		s.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("2"))
+		s.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("2"))
+	}
+	require.Equal(t, expected.Commit(), reloaded.Commit())
+}
+
+func TestCommitRepairJournal(t *testing.T) {
+	db := dbm.NewMemDB()
+	ownDB := dbm.NewMemDB()
+	key4 := types.NewKVStoreKey("store4")
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+		ms.MountStoreWithDB(key4, types.StoreTypeIAVL, ownDB)
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+
+	ms := newStore()
+	ms.GetKVStore(key4).Set([]byte("a"), []byte("1"))
+	ms.Commit()
+
+	has, err := db.Has(commitJournalKey)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a commit interrupted after the store with its own database was
+	// committed.
+	flushCommitJournal(db, 2)
+	ms.GetKVStore(key4).Set([]byte("a"), []byte("2"))
+	ms.GetCommitKVStore(key4).Commit()
+
+	reloaded := newStore()
+	require.Equal(t, int64(1), reloaded.LastCommitID().Version)
+	require.Equal(t, []int{1}, reloaded.GetCommitKVStore(key4).(*iavl.Store).GetAllVersions())
+	require.Equal(t, []byte("1"), reloaded.GetKVStore(key4).Get([]byte("a")))
+
+	has, err = db.Has(commitJournalKey)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	reloaded.GetKVStore(key4).Set([]byte("a"), []byte("2"))
+	require.Equal(t, int64(2), reloaded.Commit().Version)
+}
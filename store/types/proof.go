@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -131,6 +133,124 @@ func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	return [][]byte{root}, nil
 }
 
+// RunRange verifies that keys and values, sorted by key, are all the entries of
+// the [start, end) range committed by the batch proof of the op. A nil end
+// leaves the range open. It returns the root wrapped in [][]byte if the proof
+// op succeeds.
+//
+// Every existence proof of the batch, including the neighbors of its
+// non-existence proofs, is verified against the root. The proven leaves from
+// the last one at or before start (or the left-most leaf) up to the first one
+// at or after end (or the right-most leaf) must be neighbors, so that no leaf
+// of the range can be left out of the proof.
+func (op CommitmentOp) RunRange(start, end []byte, keys, values [][]byte) ([][]byte, error) {
+	if len(keys) != len(values) {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "got %d keys and %d values", len(keys), len(values))
+	}
+	if end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "invalid range [%X, %X)", start, end)
+	}
+
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof: %v", err)
+	}
+
+	leaves, err := provenLeaves(op.Spec, root, ics23.Decompress(op.Proof))
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "range proof is empty")
+	}
+
+	lo := sort.Search(len(leaves), func(i int) bool { return bytes.Compare(leaves[i].Key, start) > 0 }) - 1
+	if lo < 0 {
+		lo = 0
+		if !ics23.IsLeftMost(op.Spec.InnerSpec, leaves[0].Path) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "range proof doesn't prove the start bound %X", start)
+		}
+	}
+
+	hi := len(leaves) - 1
+	if end != nil {
+		hi = sort.Search(len(leaves), func(i int) bool { return bytes.Compare(leaves[i].Key, end) >= 0 })
+	}
+	if hi == len(leaves) || end == nil {
+		hi = len(leaves) - 1
+		if !ics23.IsRightMost(op.Spec.InnerSpec, leaves[hi].Path) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "range proof doesn't prove the end bound %X", end)
+		}
+	}
+
+	var i int
+	for j := lo; j <= hi; j++ {
+		if j < hi && !ics23.IsLeftNeighbor(op.Spec.InnerSpec, leaves[j].Path, leaves[j+1].Path) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "keys %X and %X are not neighbors", leaves[j].Key, leaves[j+1].Key)
+		}
+
+		leaf := leaves[j]
+		if bytes.Compare(leaf.Key, start) < 0 || (end != nil && bytes.Compare(leaf.Key, end) >= 0) {
+			continue
+		}
+		if i == len(keys) || !bytes.Equal(keys[i], leaf.Key) || !bytes.Equal(values[i], leaf.Value) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "range doesn't match proof at key %X", leaf.Key)
+		}
+		i++
+	}
+	if i != len(keys) {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "key %X is not proven in range", keys[i])
+	}
+
+	return [][]byte{root}, nil
+}
+
+// provenLeaves verifies every existence proof of the batch proof against root
+// and returns them sorted by key, without duplicates.
+func provenLeaves(spec *ics23.ProofSpec, root ics23.CommitmentRoot, proof *ics23.CommitmentProof) ([]*ics23.ExistenceProof, error) {
+	batch := proof.GetBatch()
+	if batch == nil {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "range proof must be a batch proof")
+	}
+
+	var leaves []*ics23.ExistenceProof
+	add := func(exist *ics23.ExistenceProof) error {
+		if exist == nil {
+			return nil
+		}
+		if err := exist.Verify(spec, root, exist.Key, exist.Value); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "invalid existence proof of key %X: %v", exist.Key, err)
+		}
+		leaves = append(leaves, exist)
+		return nil
+	}
+
+	for _, entry := range batch.Entries {
+		if err := add(entry.GetExist()); err != nil {
+			return nil, err
+		}
+		if nonexist := entry.GetNonexist(); nonexist != nil {
+			if err := add(nonexist.Left); err != nil {
+				return nil, err
+			}
+			if err := add(nonexist.Right); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].Key, leaves[j].Key) < 0 })
+
+	unique := make([]*ics23.ExistenceProof, 0, len(leaves))
+	for _, leaf := range leaves {
+		if len(unique) == 0 || !bytes.Equal(leaf.Key, unique[len(unique)-1].Key) {
+			unique = append(unique, leaf)
+		}
+	}
+
+	return unique, nil
+}
+
 // ProofOp implements ProofOperator interface and converts a CommitmentOp
 // into a merkle.ProofOp format that can later be decoded by CommitmentOpDecoder
 // back into a CommitmentOp for proof verification
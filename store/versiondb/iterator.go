@@ -0,0 +1,136 @@
+package versiondb
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+// iterator walks the key index of a store and yields the keys which have a
+// value at its version.
+type iterator struct {
+	ss         *Store
+	name       string
+	version    int64
+	prefixLen  int
+	source     dbm.Iterator
+	start, end []byte
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func newIterator(ss *Store, storeName string, version int64, start, end []byte, ascending bool) (*iterator, error) {
+	prefix := append(append([]byte{}, indexPrefix...), storePrefix(storeName)...)
+
+	var err error
+	var source dbm.Iterator
+	if ascending {
+		source, err = ss.db.Iterator(prefixedBound(prefix, start, false), prefixedBound(prefix, end, true))
+	} else {
+		source, err = ss.db.ReverseIterator(prefixedBound(prefix, start, false), prefixedBound(prefix, end, true))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	iter := &iterator{
+		ss:        ss,
+		name:      storeName,
+		version:   version,
+		prefixLen: len(prefix),
+		source:    source,
+		start:     start,
+		end:       end,
+	}
+	iter.skip()
+
+	return iter, nil
+}
+
+// prefixedBound returns the bound of the key index for a bound of the domain.
+func prefixedBound(prefix, bound []byte, isEnd bool) []byte {
+	if bound == nil {
+		if isEnd {
+			return types.PrefixEndBytes(prefix)
+		}
+		return prefix
+	}
+
+	return append(append([]byte{}, prefix...), bound...)
+}
+
+// skip moves the source to the first key having a value at the version,
+// starting from the current one.
+func (it *iterator) skip() {
+	for ; it.source.Valid(); it.source.Next() {
+		key := append([]byte{}, it.source.Key()[it.prefixLen:]...)
+
+		value, err := it.ss.Get(it.name, it.version, key)
+		if err != nil {
+			it.err = err
+			break
+		}
+
+		if value != nil {
+			it.key, it.value, it.valid = key, value, true
+			return
+		}
+	}
+
+	it.key, it.value, it.valid = nil, nil, false
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	it.source.Next()
+	it.skip()
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	return it.source.Error()
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
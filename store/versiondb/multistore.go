@@ -0,0 +1,127 @@
+package versiondb
+
+import (
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.MultiStore = (*MultiStore)(nil)
+
+// MultiStore is a read-only MultiStore serving the stores of a multistore from
+// the versioned index of a Store. It is meant to be set as the query multistore
+// of an archive node, so that queries at any height since the index was
+// started are served while the IAVL trees are pruned normally.
+type MultiStore struct {
+	ss         *Store
+	keysByName map[string]types.StoreKey
+
+	traceWriter  io.Writer
+	traceContext types.TraceContext
+}
+
+// NewMultiStore returns a read-only MultiStore serving the stores of keys from
+// ss.
+func NewMultiStore(ss *Store, keys []types.StoreKey) *MultiStore {
+	keysByName := make(map[string]types.StoreKey, len(keys))
+	for _, key := range keys {
+		keysByName[key.Name()] = key
+	}
+
+	return &MultiStore{ss: ss, keysByName: keysByName}
+}
+
+// GetStoreType implements Store.
+func (*MultiStore) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms *MultiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore().(types.CacheWrap)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms *MultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore branches the stores at the latest version.
+func (ms *MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return ms.cacheMultiStore(ms.LatestVersion())
+}
+
+// CacheMultiStoreWithVersion branches the stores at a version held by the
+// index. The branches can be written to, but writing them back panics.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	latest, err := ms.ss.LatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	earliest, err := ms.ss.EarliestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version < earliest || version > latest {
+		return nil, fmt.Errorf("version %d is not indexed; indexed versions are [%d, %d]", version, earliest, latest)
+	}
+
+	return ms.cacheMultiStore(version), nil
+}
+
+func (ms *MultiStore) cacheMultiStore(version int64) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(ms.keysByName))
+	for name, key := range ms.keysByName {
+		stores[key] = ms.ss.KVStore(name, version)
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, ms.keysByName, ms.traceWriter, ms.traceContext)
+}
+
+// GetStore returns a read-only view of a store at the latest version. It
+// panics if the store is not served.
+func (ms *MultiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore returns a read-only view of a store at the latest version. It
+// panics if the store is not served.
+func (ms *MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	if _, ok := ms.keysByName[key.Name()]; !ok {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+
+	return ms.ss.KVStore(key.Name(), ms.LatestVersion())
+}
+
+// TracingEnabled implements MultiStore.
+func (ms *MultiStore) TracingEnabled() bool {
+	return ms.traceWriter != nil
+}
+
+// SetTracer implements MultiStore.
+func (ms *MultiStore) SetTracer(w io.Writer) types.MultiStore {
+	ms.traceWriter = w
+	return ms
+}
+
+// SetTracingContext implements MultiStore.
+func (ms *MultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	ms.traceContext = ms.traceContext.Merge(tc)
+	return ms
+}
+
+// LatestVersion returns the latest version of the index. It panics if it
+// cannot be read.
+func (ms *MultiStore) LatestVersion() int64 {
+	version, err := ms.ss.LatestVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	return version
+}
@@ -0,0 +1,131 @@
+package versiondb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
+)
+
+var (
+	bankKey    = types.NewKVStoreKey("bank")
+	stakingKey = types.NewKVStoreKey("staking")
+)
+
+func newMultiStore(t *testing.T) *rootmulti.Store {
+	t.Helper()
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.SetPruning(pruningtypes.NewCustomPruningOptions(1, 1))
+	cms.MountStoreWithDB(bankKey, types.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(stakingKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return cms
+}
+
+// commitBlock writes the state of height and streams its commit.
+func commitBlock(t *testing.T, cms *rootmulti.Store, listener types.ABCIListener, height int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, listener.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+
+	bank := cms.GetKVStore(bankKey)
+	bank.Set([]byte("balance"), []byte(fmt.Sprint(height)))
+	bank.Set([]byte(fmt.Sprintf("account%d", height)), []byte("new"))
+	if height > 1 {
+		bank.Delete([]byte(fmt.Sprintf("account%d", height-1)))
+	}
+	cms.GetKVStore(stakingKey).Set([]byte("validator"), []byte(fmt.Sprint(height*10)))
+
+	res := abci.ResponseCommit{Data: cms.Commit().Hash}
+	require.NoError(t, listener.ListenCommit(ctx, res, cms.PopStateCache()))
+}
+
+func TestMultiStore_Archive(t *testing.T) {
+	cms := newMultiStore(t)
+	cms.AddListeners([]types.StoreKey{bankKey, stakingKey})
+
+	ss := versiondb.NewStore(dbm.NewMemDB())
+	listener := versiondb.NewStreamingService(ss)
+	for height := int64(1); height <= 10; height++ {
+		commitBlock(t, cms, listener, height)
+	}
+
+	// the IAVL trees are pruned, but the index serves every height.
+	_, err := cms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	ms := versiondb.NewMultiStore(ss, []types.StoreKey{bankKey, stakingKey})
+	require.Equal(t, int64(10), ms.LatestVersion())
+
+	for height := int64(1); height <= 10; height++ {
+		cache, err := ms.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+
+		bank := cache.GetKVStore(bankKey)
+		require.Equal(t, []byte(fmt.Sprint(height)), bank.Get([]byte("balance")))
+		require.Equal(t, []byte("new"), bank.Get([]byte(fmt.Sprintf("account%d", height))))
+		require.Nil(t, bank.Get([]byte(fmt.Sprintf("account%d", height-1))))
+		require.Equal(t, []byte(fmt.Sprint(height*10)), cache.GetKVStore(stakingKey).Get([]byte("validator")))
+
+		// queries can write to their branch without touching the index.
+		bank.Set([]byte("balance"), []byte("changed"))
+	}
+
+	require.Equal(t, []byte("10"), ms.GetKVStore(bankKey).Get([]byte("balance")))
+	require.Panics(t, func() { ms.GetKVStore(bankKey).Set([]byte("balance"), []byte("changed")) })
+	require.Panics(t, func() { ms.GetKVStore(types.NewKVStoreKey("gov")) })
+
+	_, err = ms.CacheMultiStoreWithVersion(0)
+	require.Error(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(11)
+	require.Error(t, err)
+}
+
+func TestMultiStore_ImportMultiStore(t *testing.T) {
+	cms := newMultiStore(t)
+	cms.AddListeners([]types.StoreKey{bankKey, stakingKey})
+
+	// the chain runs before the index is started.
+	discard := versiondb.NewStreamingService(versiondb.NewStore(dbm.NewMemDB()))
+	for height := int64(1); height <= 3; height++ {
+		commitBlock(t, cms, discard, height)
+	}
+
+	ss := versiondb.NewStore(dbm.NewMemDB())
+	require.NoError(t, ss.ImportMultiStore(3, cms, []types.StoreKey{bankKey, stakingKey}))
+	require.Error(t, ss.ImportMultiStore(3, cms, []types.StoreKey{bankKey, stakingKey}))
+
+	listener := versiondb.NewStreamingService(ss)
+	for height := int64(4); height <= 5; height++ {
+		commitBlock(t, cms, listener, height)
+	}
+
+	ms := versiondb.NewMultiStore(ss, []types.StoreKey{bankKey, stakingKey})
+	_, err := ms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	cache, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), cache.GetKVStore(bankKey).Get([]byte("balance")))
+	require.Equal(t, []byte("new"), cache.GetKVStore(bankKey).Get([]byte("account3")))
+
+	cache, err = ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte("5"), cache.GetKVStore(bankKey).Get([]byte("balance")))
+	require.Nil(t, cache.GetKVStore(bankKey).Get([]byte("account3")))
+	require.Equal(t, []byte("50"), cache.GetKVStore(stakingKey).Get([]byte("validator")))
+}
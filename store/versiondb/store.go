@@ -0,0 +1,367 @@
+package versiondb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+// The layout of the state storage in its database is:
+//
+//	l                                                 -> latest version
+//	e                                                 -> earliest version
+//	i | len(store) | store | key                      -> nil (key index)
+//	v | len(store) | store | len(key) | key | version -> flag | value
+//
+// Lengths are uvarints and versions are big endian uint64, so that the entries
+// of a key are ordered by version. The key index is ordered by key and only
+// serves iteration, the value of a key at a version is the entry with the
+// greatest version lower or equal to it.
+var (
+	latestVersionKey   = []byte{'l'}
+	earliestVersionKey = []byte{'e'}
+	indexPrefix        = []byte{'i'}
+	valuePrefix        = []byte{'v'}
+)
+
+const (
+	flagDeleted byte = iota
+	flagSet
+)
+
+// importBatchSize is the number of keys written per batch by Import.
+const importBatchSize = 10000
+
+// Store is a flat versioned key-value storage holding the full history of the
+// stores of a multistore. Unlike the IAVL trees it does not commit to its
+// content, but a key is read at any version with a single seek.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a state storage using db, which must not be shared with
+// other data unless it is prefixed.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// LatestVersion returns the latest version written, 0 if none.
+func (s *Store) LatestVersion() (int64, error) {
+	return s.getVersion(latestVersionKey)
+}
+
+// EarliestVersion returns the first version whose full state is held by the
+// state storage, 0 if none.
+func (s *Store) EarliestVersion() (int64, error) {
+	return s.getVersion(earliestVersionKey)
+}
+
+func (s *Store) getVersion(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// WriteChangeSet writes the changes of a version and makes it the latest
+// version. The first version written is also recorded as the earliest one.
+// A version may be written several times, e.g. when a commit is replayed after
+// a crash, but never before the latest version.
+func (s *Store) WriteChangeSet(version int64, changes []*types.StoreKVPair) error {
+	latest, err := s.LatestVersion()
+	if err != nil {
+		return err
+	}
+	if version < latest {
+		return fmt.Errorf("cannot write version %d before the latest version %d", version, latest)
+	}
+
+	earliest, err := s.EarliestVersion()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changes {
+		if err := writePair(batch, version, pair); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(latestVersionKey, versionBytes(version)); err != nil {
+		return err
+	}
+	if earliest == 0 {
+		if err := batch.Set(earliestVersionKey, versionBytes(version)); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// Import writes the state of a store at a version, e.g. when the state storage
+// is enabled on an existing chain. It doesn't change the latest version, which
+// must be written with WriteChangeSet once all the stores are imported.
+func (s *Store) Import(version int64, storeName string, iter types.Iterator) error {
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	n := 0
+	for ; iter.Valid(); iter.Next() {
+		pair := &types.StoreKVPair{StoreKey: storeName, Key: iter.Key(), Value: iter.Value()}
+		if err := writePair(batch, version, pair); err != nil {
+			return err
+		}
+
+		n++
+		if n%importBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// ImportMultiStore writes the state of the IAVL stores of keys in cms at a
+// version and makes it the earliest and latest version, so that an index can
+// be started on an existing chain. It must be called on an empty index.
+func (s *Store) ImportMultiStore(version int64, cms types.CommitMultiStore, keys []types.StoreKey) error {
+	latest, err := s.LatestVersion()
+	if err != nil {
+		return err
+	}
+	if latest != 0 {
+		return fmt.Errorf("cannot import version %d into an index at version %d", version, latest)
+	}
+
+	for _, key := range keys {
+		store, ok := cms.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			return fmt.Errorf("cannot import store %s: not an IAVL store", key.Name())
+		}
+
+		// the immutable tree is iterated directly, so that an iteration error
+		// fails the import instead of truncating it.
+		immutable, err := store.GetImmutable(version)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Import(version, key.Name(), immutable.Iterator(nil, nil)); err != nil {
+			return fmt.Errorf("failed to import store %s: %w", key.Name(), err)
+		}
+	}
+
+	return s.WriteChangeSet(version, nil)
+}
+
+// Rollback deletes the versions after target and makes it the latest version.
+// The key index is left untouched, as keys without a value are skipped by
+// iteration anyway.
+func (s *Store) Rollback(target int64) error {
+	earliest, err := s.EarliestVersion()
+	if err != nil {
+		return err
+	}
+	if target < earliest {
+		return fmt.Errorf("cannot rollback to version %d before the earliest version %d", target, earliest)
+	}
+
+	iter, err := s.db.Iterator(valuePrefix, types.PrefixEndBytes(valuePrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if int64(binary.BigEndian.Uint64(key[len(key)-8:])) > target {
+			keys = append(keys, append([]byte{}, key...))
+		}
+	}
+	err = iter.Error()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestVersionKey, versionBytes(target)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Get returns the value of a key of a store at a version, nil if the key
+// doesn't exist at that version.
+func (s *Store) Get(storeName string, version int64, key []byte) ([]byte, error) {
+	prefix := valueKeyPrefix(storePrefix(storeName), key)
+	iter, err := s.db.ReverseIterator(prefix, valueKey(prefix, version+1))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, iter.Error()
+	}
+
+	bz := iter.Value()
+	if bz[0] == flagDeleted {
+		return nil, nil
+	}
+
+	return append([]byte{}, bz[1:]...), nil
+}
+
+// Iterator returns an iterator over the keys of a store in the domain
+// [start, end) at a version, in ascending order.
+func (s *Store) Iterator(storeName string, version int64, start, end []byte) (types.Iterator, error) {
+	return newIterator(s, storeName, version, start, end, true)
+}
+
+// ReverseIterator returns an iterator over the keys of a store in the domain
+// [start, end) at a version, in descending order.
+func (s *Store) ReverseIterator(storeName string, version int64, start, end []byte) (types.Iterator, error) {
+	return newIterator(s, storeName, version, start, end, false)
+}
+
+// KVStore returns a read-only view of a store at a version. The caller is
+// responsible for checking that the version is held by the state storage.
+func (s *Store) KVStore(storeName string, version int64) types.KVStore {
+	return &kvStore{ss: s, name: storeName, version: version}
+}
+
+func writePair(batch dbm.Batch, version int64, pair *types.StoreKVPair) error {
+	prefix := storePrefix(pair.StoreKey)
+	key := valueKey(valueKeyPrefix(prefix, pair.Key), version)
+
+	if pair.Delete {
+		return batch.Set(key, []byte{flagDeleted})
+	}
+
+	if err := batch.Set(append(append(append([]byte{}, indexPrefix...), prefix...), pair.Key...), []byte{}); err != nil {
+		return err
+	}
+
+	return batch.Set(key, append([]byte{flagSet}, pair.Value...))
+}
+
+func storePrefix(storeName string) []byte {
+	bz := binary.AppendUvarint(nil, uint64(len(storeName)))
+	return append(bz, storeName...)
+}
+
+func valueKeyPrefix(storePrefix, key []byte) []byte {
+	bz := append(append([]byte{}, valuePrefix...), storePrefix...)
+	bz = binary.AppendUvarint(bz, uint64(len(key)))
+	return append(bz, key...)
+}
+
+func valueKey(prefix []byte, version int64) []byte {
+	return append(append([]byte{}, prefix...), versionBytes(version)...)
+}
+
+func versionBytes(version int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(version))
+}
+
+// kvStore is a read-only view of a store at a version.
+type kvStore struct {
+	ss      *Store
+	name    string
+	version int64
+}
+
+var _ types.KVStore = (*kvStore)(nil)
+
+// GetStoreType implements Store.
+func (*kvStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// Get implements KVStore.
+func (st *kvStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	value, err := st.ss.Get(st.name, st.version, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Has implements KVStore.
+func (st *kvStore) Has(key []byte) bool {
+	return st.Get(key) != nil
+}
+
+// Set implements KVStore.
+func (*kvStore) Set(_, _ []byte) {
+	panic("cannot call 'Set' on a state storage view")
+}
+
+// Delete implements KVStore.
+func (*kvStore) Delete(_ []byte) {
+	panic("cannot call 'Delete' on a state storage view")
+}
+
+// Iterator implements KVStore.
+func (st *kvStore) Iterator(start, end []byte) types.Iterator {
+	iter, err := st.ss.Iterator(st.name, st.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return iter
+}
+
+// ReverseIterator implements KVStore.
+func (st *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	iter, err := st.ss.ReverseIterator(st.name, st.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return iter
+}
+
+// CacheWrap implements Store.
+func (st *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements Store.
+func (st *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
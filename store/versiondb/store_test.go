@@ -0,0 +1,210 @@
+package versiondb
+
+import (
+	"errors"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+func set(store, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: store, Key: []byte(key), Value: []byte(value)}
+}
+
+func del(store, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: store, Delete: true, Key: []byte(key)}
+}
+
+func collect(t *testing.T, iter types.Iterator) []string {
+	t.Helper()
+	defer iter.Close()
+
+	var kvs []string
+	for ; iter.Valid(); iter.Next() {
+		kvs = append(kvs, string(iter.Key())+"="+string(iter.Value()))
+	}
+	require.NoError(t, iter.Error())
+
+	return kvs
+}
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	s := NewStore(dbm.NewMemDB())
+	require.NoError(t, s.WriteChangeSet(1, []*types.StoreKVPair{
+		set("bank", "a", "1"), set("bank", "b", "1"), set("bank", "c", "1"), set("acc", "a", "acc"),
+	}))
+	require.NoError(t, s.WriteChangeSet(2, []*types.StoreKVPair{
+		set("bank", "b", "2"), del("bank", "c"), set("bank", "bb", "2"),
+	}))
+	require.NoError(t, s.WriteChangeSet(3, []*types.StoreKVPair{
+		set("bank", "c", "3"), del("bank", "a"),
+	}))
+
+	return s
+}
+
+func TestStore_Versions(t *testing.T) {
+	s := NewStore(dbm.NewMemDB())
+
+	latest, err := s.LatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latest)
+
+	require.NoError(t, s.WriteChangeSet(5, nil))
+	require.NoError(t, s.WriteChangeSet(6, nil))
+	require.NoError(t, s.WriteChangeSet(6, nil))
+	require.Error(t, s.WriteChangeSet(4, nil))
+
+	latest, err = s.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), latest)
+
+	earliest, err := s.EarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), earliest)
+}
+
+func TestStore_Get(t *testing.T) {
+	s := newTestStore(t)
+
+	testCases := []struct {
+		version int64
+		key     string
+		value   []byte
+	}{
+		{0, "a", nil},
+		{1, "a", []byte("1")},
+		{2, "a", []byte("1")},
+		{3, "a", nil},
+		{1, "c", []byte("1")},
+		{2, "c", nil},
+		{3, "c", []byte("3")},
+		{10, "c", []byte("3")},
+		{1, "bb", nil},
+		{2, "bb", []byte("2")},
+		{3, "x", nil},
+	}
+
+	for _, tc := range testCases {
+		value, err := s.Get("bank", tc.version, []byte(tc.key))
+		require.NoError(t, err)
+		require.Equal(t, tc.value, value, "key %s at version %d", tc.key, tc.version)
+	}
+
+	value, err := s.Get("acc", 3, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("acc"), value)
+}
+
+func TestStore_Iterator(t *testing.T) {
+	s := newTestStore(t)
+
+	testCases := []struct {
+		version    int64
+		start, end []byte
+		ascending  bool
+		expected   []string
+	}{
+		{1, nil, nil, true, []string{"a=1", "b=1", "c=1"}},
+		{2, nil, nil, true, []string{"a=1", "b=2", "bb=2"}},
+		{3, nil, nil, true, []string{"b=2", "bb=2", "c=3"}},
+		{3, nil, nil, false, []string{"c=3", "bb=2", "b=2"}},
+		{2, []byte("b"), []byte("c"), true, []string{"b=2", "bb=2"}},
+		{2, []byte("b"), []byte("c"), false, []string{"bb=2", "b=2"}},
+		{3, []byte("bc"), nil, true, []string{"c=3"}},
+		{0, nil, nil, true, nil},
+	}
+
+	for _, tc := range testCases {
+		var iter types.Iterator
+		var err error
+		if tc.ascending {
+			iter, err = s.Iterator("bank", tc.version, tc.start, tc.end)
+		} else {
+			iter, err = s.ReverseIterator("bank", tc.version, tc.start, tc.end)
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, collect(t, iter), "version %d [%s, %s)", tc.version, tc.start, tc.end)
+	}
+
+	require.Equal(t, []string{"a=acc"}, collect(t, s.KVStore("acc", 1).Iterator(nil, nil)))
+}
+
+func TestStore_KVStore(t *testing.T) {
+	s := newTestStore(t)
+	kv := s.KVStore("bank", 2)
+
+	require.True(t, kv.Has([]byte("bb")))
+	require.False(t, kv.Has([]byte("c")))
+	require.Panics(t, func() { kv.Set([]byte("c"), []byte("2")) })
+	require.Panics(t, func() { kv.Delete([]byte("a")) })
+
+	// branches are writable and don't change the version.
+	cache := kv.CacheWrap().(types.KVStore)
+	cache.Set([]byte("c"), []byte("cached"))
+	require.Equal(t, []byte("cached"), cache.Get([]byte("c")))
+	require.Nil(t, kv.Get([]byte("c")))
+}
+
+func TestStore_Import(t *testing.T) {
+	source := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, key := range []string{"a", "b", "c"} {
+		source.Set([]byte(key), []byte(key))
+	}
+
+	s := NewStore(dbm.NewMemDB())
+	require.NoError(t, s.Import(7, "bank", source.Iterator(nil, nil)))
+	require.NoError(t, s.WriteChangeSet(7, nil))
+	require.NoError(t, s.WriteChangeSet(8, []*types.StoreKVPair{del("bank", "b")}))
+
+	require.Equal(t, []string{"a=a", "b=b", "c=c"}, collect(t, s.KVStore("bank", 7).Iterator(nil, nil)))
+	require.Equal(t, []string{"a=a", "c=c"}, collect(t, s.KVStore("bank", 8).Iterator(nil, nil)))
+
+	earliest, err := s.EarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), earliest)
+}
+
+// failingIterator stops after its first key with an error.
+type failingIterator struct {
+	types.Iterator
+}
+
+func (iter failingIterator) Error() error {
+	if !iter.Valid() {
+		return errors.New("read failed")
+	}
+
+	return nil
+}
+
+func TestStore_ImportError(t *testing.T) {
+	source := dbadapter.Store{DB: dbm.NewMemDB()}
+	source.Set([]byte("a"), []byte("a"))
+
+	s := NewStore(dbm.NewMemDB())
+	require.ErrorContains(t, s.Import(7, "bank", failingIterator{source.Iterator(nil, nil)}), "read failed")
+}
+
+func TestStore_Rollback(t *testing.T) {
+	s := newTestStore(t)
+
+	require.Error(t, s.Rollback(0))
+	require.NoError(t, s.Rollback(1))
+
+	latest, err := s.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), latest)
+
+	require.Equal(t, []string{"a=1", "b=1", "c=1"}, collect(t, s.KVStore("bank", 3).Iterator(nil, nil)))
+
+	// the rolled back versions can be written again.
+	require.NoError(t, s.WriteChangeSet(2, []*types.StoreKVPair{set("bank", "d", "2")}))
+	require.Equal(t, []string{"a=1", "b=1", "c=1", "d=2"}, collect(t, s.KVStore("bank", 2).Iterator(nil, nil)))
+}
@@ -0,0 +1,45 @@
+package versiondb
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.ABCIListener = (*StreamingService)(nil)
+
+// StreamingService is an ABCIListener indexing the change set of every commit
+// into a Store, at the height of the block. The multistore must listen to every
+// served store so that the change sets are complete.
+type StreamingService struct {
+	ss     *Store
+	height int64
+}
+
+// NewStreamingService returns an ABCIListener indexing the change sets in ss.
+func NewStreamingService(ss *Store) *StreamingService {
+	return &StreamingService{ss: ss}
+}
+
+// ListenBeginBlock records the height of the block being committed.
+func (s *StreamingService) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.height = req.Header.Height
+	return nil
+}
+
+// ListenEndBlock implements ABCIListener.
+func (*StreamingService) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements ABCIListener.
+func (*StreamingService) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit writes the change set of the block to the index.
+func (s *StreamingService) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	return s.ss.WriteChangeSet(s.height, changeSet)
+}
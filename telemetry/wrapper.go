@@ -64,6 +64,12 @@ func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
 	metrics.SetGaugeWithLabels(keys, val, append(labels, globalLabels...))
 }
 
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric with global labels (if any) along with the provided labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	metrics.AddSampleWithLabels(keys, val, append(labels, globalLabels...))
+}
+
 // MeasureSince provides a wrapper functionality for emitting a a time measure
 // metric with global labels (if any).
 func MeasureSince(start time.Time, keys ...string) {
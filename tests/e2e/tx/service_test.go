@@ -237,6 +237,83 @@ func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 	}
 }
 
+func (s *E2ETestSuite) TestSimulateBundle_GRPC() {
+	val := s.network.Validators[0]
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(s.mkTxBuilder().GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.SimulateBundleRequest
+		expErrMsg string
+		expCodes  []uint32
+	}{
+		{"nil request", nil, "request cannot be nil", nil},
+		{"empty request", &tx.SimulateBundleRequest{}, "empty bundle is not allowed", nil},
+		{"valid request", &tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes}}, "", []uint32{0}},
+		{
+			"tx replayed in the bundle",
+			&tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes, txBytes}},
+			"",
+			[]uint32{0, sdkerrors.ErrWrongSequence.ABCICode()},
+		},
+		{
+			"balance override",
+			&tx.SimulateBundleRequest{
+				TxsBytes: [][]byte{txBytes},
+				Overrides: tx.StateOverrides{
+					Balances: []tx.BalanceOverride{{Address: val.Address.String(), Coins: sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 0)}}},
+				},
+			},
+			"",
+			[]uint32{sdkerrors.ErrInsufficientFunds.ABCICode()},
+		},
+		{
+			"unknown store override",
+			&tx.SimulateBundleRequest{
+				TxsBytes: [][]byte{txBytes},
+				Overrides: tx.StateOverrides{
+					Stores: []tx.StoreOverride{{StoreKey: "foo", Key: []byte("bar"), Delete: true}},
+				},
+			},
+			"unknown store",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.SimulateBundle(context.Background(), tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Len(res.Results, len(tc.expCodes))
+			for i, code := range tc.expCodes {
+				s.Require().Equal(code, res.Results[i].Code, res.Results[i].Log)
+				s.Require().True(res.Results[i].GasInfo.GasUsed > 0)
+			}
+			if tc.expCodes[0] == 0 {
+				s.Require().Equal(12, len(res.Results[0].Result.Events)) // See TestSimulateTx_GRPC for the 12 events.
+			}
+		})
+	}
+
+	// the bundle is also served by the gRPC gateway
+	req, err := val.ClientCtx.Codec.MarshalJSON(&tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes}})
+	s.Require().NoError(err)
+	res, err := testutil.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/simulate_bundle", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+
+	var result tx.SimulateBundleResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+	s.Require().Len(result.Results, 1)
+	s.Require().Zero(result.Results[0].Code, result.Results[0].Log)
+}
+
 func (s *E2ETestSuite) TestGetTxEvents_GRPC() {
 	testCases := []struct {
 		name      string
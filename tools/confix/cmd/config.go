@@ -17,6 +17,8 @@ func ConfigCommand() *cobra.Command {
 		DiffCommand(),
 		GetCommand(),
 		SetCommand(),
+		ValidateCommand(),
+		ViewCommand(),
 		HomeCommand(),
 	)
 
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"cosmossdk.io/tools/confix"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// FlagStrict reports unknown keys as errors.
+var FlagStrict bool
+
+// ValidateCommand returns a CLI command to validate an application config file.
+func ValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [config]",
+		Short: "Validate an application config file against the Cosmos SDK config schema",
+		Long: `Validate an application config file (app.toml or client.toml) against the config schema of the installed Cosmos SDK version.
+It reports unknown keys, values of the wrong type, invalid enum values and inconsistent settings, such as custom pruning options that conflict with state sync snapshots.
+The [config] argument must be the path of the file when using the tool standalone, otherwise it must be the name of the config file without the .toml extension.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			if clientCtx.HomeDir != "" {
+				filename = fmt.Sprintf("%s/config/%s.toml", clientCtx.HomeDir, filename)
+			}
+
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			issues, err := confix.Validate(filename, data, FlagStrict)
+			if err != nil {
+				return err
+			}
+
+			for _, issue := range issues {
+				cmd.Println(issue.String())
+			}
+
+			if confix.HasErrors(issues) {
+				return errors.New("config is invalid")
+			}
+
+			if len(issues) == 0 {
+				cmd.Println("config is valid")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&FlagStrict, "strict", false, "report unknown keys as errors")
+
+	return cmd
+}
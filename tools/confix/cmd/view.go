@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/tools/confix"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// FlagNonDefault only shows the settings that differ from the defaults.
+var FlagNonDefault bool
+
+// ViewCommand returns a CLI command to view an application config file.
+func ViewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view [config]",
+		Short: "View an application config file",
+		Long: `View an application config file (app.toml or client.toml).
+With --non-default, only the settings that differ from the defaults of the installed Cosmos SDK version are shown.
+The [config] argument must be the path of the file when using the tool standalone, otherwise it must be the name of the config file without the .toml extension.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			if clientCtx.HomeDir != "" {
+				filename = fmt.Sprintf("%s/config/%s.toml", clientCtx.HomeDir, filename)
+			}
+
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			if !FlagNonDefault {
+				cmd.Print(string(data))
+				return nil
+			}
+
+			kvs, err := confix.NonDefaultValues(filename, data)
+			if err != nil {
+				return err
+			}
+
+			if len(kvs) == 0 {
+				cmd.Println("All config values are the same as the defaults.")
+				return nil
+			}
+
+			confix.PrintKVs(cmd.OutOrStdout(), kvs)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&FlagNonDefault, "non-default", false, "only show the settings that differ from the defaults")
+
+	return cmd
+}
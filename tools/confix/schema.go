@@ -0,0 +1,156 @@
+package confix
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/mitchellh/mapstructure"
+
+	clientcfg "github.com/cosmos/cosmos-sdk/client/config"
+	srvcfg "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// SchemaField describes a configuration key known by the Cosmos SDK.
+type SchemaField struct {
+	// Key is the full key of the field, e.g. api.enable.
+	Key string
+	// Type is the Go type the value is decoded into.
+	Type reflect.Type
+	// Default is the value of the field in the default configuration.
+	Default reflect.Value
+}
+
+// Schema maps configuration keys to their schema field.
+type Schema map[string]SchemaField
+
+// SchemaFor returns the schema of the given configuration file, derived from
+// the default server config (app.toml) or client config (client.toml) of the
+// installed Cosmos SDK version.
+func SchemaFor(fileName string) (Schema, error) {
+	switch {
+	case strings.HasSuffix(fileName, AppConfig):
+		return NewSchema(srvcfg.DefaultConfig())
+	case strings.HasSuffix(fileName, ClientConfig):
+		return NewSchema(clientcfg.DefaultConfig())
+	case strings.HasSuffix(fileName, CMTConfig):
+		return nil, fmt.Errorf("cometbft config is not supported")
+	default:
+		return nil, fmt.Errorf("unknown config: %s", fileName)
+	}
+}
+
+// NewSchema derives a schema from a configuration struct using its
+// mapstructure tags, the same way viper decodes it.
+func NewSchema(defaultCfg interface{}) (Schema, error) {
+	v := reflect.ValueOf(defaultCfg)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %s", v.Kind())
+	}
+
+	schema := Schema{}
+	schema.add("", v)
+
+	return schema, nil
+}
+
+func (s Schema) add(prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, squash := fieldName(field)
+		value := v.Field(i)
+
+		if squash {
+			s.add(prefix, value)
+			continue
+		}
+
+		key := strings.ToLower(prefix + name)
+		if field.Type.Kind() == reflect.Struct {
+			s.add(key+".", value)
+			continue
+		}
+
+		s[key] = SchemaField{Key: key, Type: field.Type, Default: value}
+	}
+}
+
+// fieldName returns the configuration key of a struct field and whether it is
+// squashed into its parent.
+func fieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("mapstructure")
+	name, opts, _ := strings.Cut(tag, ",")
+	if opts == "squash" || (field.Anonymous && name == "") {
+		return "", true
+	}
+
+	if name != "" {
+		return name, false
+	}
+
+	// untagged fields are written in kebab case in the config templates.
+	var b strings.Builder
+	for i, r := range field.Name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String(), false
+}
+
+// Lookup returns the schema field of the given key.
+func (s Schema) Lookup(key string) (SchemaField, bool) {
+	f, ok := s[strings.ToLower(key)]
+	return f, ok
+}
+
+// Decode decodes a raw configuration value into the type of the field, with
+// the same weak typing rules as viper.
+func (f SchemaField) Decode(raw interface{}) (reflect.Value, error) {
+	out := reflect.New(f.Type)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           out.Interface(),
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+	})
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if err := decoder.Decode(raw); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return out.Elem(), nil
+}
+
+// IsDefault returns true if the raw configuration value equals the default
+// value of the field.
+func (f SchemaField) IsDefault(raw interface{}) bool {
+	v, err := f.Decode(raw)
+	if err != nil {
+		return false
+	}
+
+	// treat nil and empty slices and maps the same.
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Len() == 0 && f.Default.Len() == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(v.Interface(), f.Default.Interface())
+}
@@ -0,0 +1,250 @@
+package confix
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+
+	clientcfg "github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	srvcfg "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Severity is the severity of a validation issue.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationIssue is a problem found while validating a configuration file.
+type ValidationIssue struct {
+	Key      string
+	Severity Severity
+	Message  string
+}
+
+func (i ValidationIssue) String() string {
+	if i.Key == "" {
+		return fmt.Sprintf("%s: %s", i.Severity, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Key, i.Message)
+}
+
+// HasErrors returns true if at least one of the issues is an error.
+func HasErrors(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// enumValues defines the allowed values of enum-like configuration keys.
+var enumValues = map[string]map[string][]string{
+	AppConfig: {
+		"pruning": {
+			pruningtypes.PruningOptionDefault,
+			pruningtypes.PruningOptionNothing,
+			pruningtypes.PruningOptionEverything,
+			pruningtypes.PruningOptionCustom,
+		},
+		"app-db-backend": {
+			"", // defaults to the CometBFT db-backend
+			string(dbm.GoLevelDBBackend),
+			string(dbm.MemDBBackend),
+			string(dbm.RocksDBBackend),
+			string(dbm.PebbleDBBackend),
+		},
+	},
+	ClientConfig: {
+		"keyring-backend": {
+			keyring.BackendOS,
+			keyring.BackendFile,
+			keyring.BackendKWallet,
+			keyring.BackendPass,
+			keyring.BackendTest,
+			keyring.BackendMemory,
+		},
+		"output":         {"text", "json"},
+		"broadcast-mode": {flags.BroadcastSync, flags.BroadcastAsync},
+	},
+}
+
+// Validate checks the configuration file fileName, with the given content,
+// against the configuration schema of the installed Cosmos SDK version.
+// It reports unknown keys, values that cannot be decoded into the expected
+// type, invalid enum values and inconsistent combinations of settings.
+//
+// Unknown keys are reported as warnings, as applications may extend the
+// configuration with their own settings, unless strict is true.
+func Validate(fileName string, data []byte, strict bool) ([]ValidationIssue, error) {
+	schema, err := SchemaFor(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	configType := AppConfig
+	if strings.HasSuffix(fileName, ClientConfig) {
+		configType = ClientConfig
+	}
+
+	var issues []ValidationIssue
+	keys := v.AllKeys()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := schema.Lookup(key)
+		if !ok {
+			severity := SeverityWarning
+			if strict {
+				severity = SeverityError
+			}
+			issues = append(issues, ValidationIssue{Key: key, Severity: severity, Message: "unknown key"})
+			continue
+		}
+
+		value, err := field.Decode(v.Get(key))
+		if err != nil {
+			issues = append(issues, ValidationIssue{
+				Key:      key,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("invalid value %v, expected %s: %v", v.Get(key), field.Type, err),
+			})
+			continue
+		}
+
+		if allowed, ok := enumValues[configType][key]; ok && !slices.Contains(allowed, value.String()) {
+			issues = append(issues, ValidationIssue{
+				Key:      key,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("invalid value %q, must be one of %q", value.String(), allowed),
+			})
+		}
+	}
+
+	// cross-field rules are only checked when the whole file can be decoded,
+	// decoding errors are already reported above.
+	switch configType {
+	case AppConfig:
+		cfg := srvcfg.DefaultConfig()
+		if err := v.Unmarshal(cfg); err == nil {
+			issues = append(issues, validateAppConfig(*cfg)...)
+		}
+	case ClientConfig:
+		cfg := clientcfg.DefaultConfig()
+		if err := v.Unmarshal(cfg); err == nil {
+			issues = append(issues, validateClientConfig(*cfg)...)
+		}
+	}
+
+	return issues, nil
+}
+
+// validateAppConfig checks the rules spanning several app.toml settings.
+func validateAppConfig(cfg srvcfg.Config) []ValidationIssue {
+	var issues []ValidationIssue
+	addIssue := func(key string, severity Severity, format string, args ...interface{}) {
+		issues = append(issues, ValidationIssue{Key: key, Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if cfg.MinGasPrices == "" {
+		addIssue("minimum-gas-prices", SeverityError, "must be set, e.g. 0stake")
+	} else if _, err := sdk.ParseDecCoins(cfg.MinGasPrices); err != nil {
+		addIssue("minimum-gas-prices", SeverityError, "invalid gas prices %q: %v", cfg.MinGasPrices, err)
+	}
+
+	keepRecent, keepRecentErr := strconv.ParseUint(cfg.PruningKeepRecent, 10, 64)
+	if keepRecentErr != nil {
+		addIssue("pruning-keep-recent", SeverityError, "must be an unsigned integer, got %q", cfg.PruningKeepRecent)
+	}
+
+	interval, intervalErr := strconv.ParseUint(cfg.PruningInterval, 10, 64)
+	if intervalErr != nil {
+		addIssue("pruning-interval", SeverityError, "must be an unsigned integer, got %q", cfg.PruningInterval)
+	}
+
+	switch cfg.Pruning {
+	case pruningtypes.PruningOptionCustom:
+		if keepRecentErr == nil && intervalErr == nil {
+			if err := pruningtypes.NewCustomPruningOptions(keepRecent, interval).Validate(); err != nil {
+				addIssue("pruning", SeverityError, "invalid custom pruning options: %v", err)
+			}
+
+			if cfg.StateSync.SnapshotInterval > 0 && keepRecent < cfg.StateSync.SnapshotInterval {
+				addIssue("pruning-keep-recent", SeverityWarning,
+					"pruning-keep-recent (%d) is lower than state-sync.snapshot-interval (%d), only snapshot heights are kept between snapshots",
+					keepRecent, cfg.StateSync.SnapshotInterval)
+			}
+		}
+
+	case pruningtypes.PruningOptionEverything:
+		if cfg.StateSync.SnapshotInterval > 0 {
+			addIssue("state-sync.snapshot-interval", SeverityError,
+				"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything)
+		}
+	}
+
+	if cfg.Pruning != pruningtypes.PruningOptionCustom && ((keepRecentErr == nil && keepRecent != 0) || (intervalErr == nil && interval != 0)) {
+		addIssue("pruning", SeverityWarning, "pruning-keep-recent and pruning-interval are ignored unless pruning is '%s'", pruningtypes.PruningOptionCustom)
+	}
+
+	if cfg.API.Enable && cfg.API.Address == "" {
+		addIssue("api.address", SeverityError, "must be set when the API server is enabled")
+	}
+
+	if cfg.GRPC.Enable && cfg.GRPC.Address == "" {
+		addIssue("grpc.address", SeverityError, "must be set when the gRPC server is enabled")
+	}
+
+	if cfg.GRPCWeb.Enable && !cfg.GRPC.Enable {
+		addIssue("grpc-web.enable", SeverityWarning, "gRPC-web is not served when the gRPC server is disabled")
+	}
+
+	for i, label := range cfg.Telemetry.GlobalLabels {
+		if len(label) != 2 {
+			addIssue("telemetry.global-labels", SeverityError, "label %d must be a [name, value] pair, got %q", i, label)
+		}
+	}
+
+	if len(cfg.Streaming.ABCI.Keys) > 0 && cfg.Streaming.ABCI.Plugin == "" {
+		addIssue("streaming.abci.plugin", SeverityError, "must be set when streaming.abci.keys is not empty")
+	}
+
+	return issues
+}
+
+// validateClientConfig checks the rules spanning several client.toml settings.
+func validateClientConfig(cfg clientcfg.ClientConfig) []ValidationIssue {
+	var issues []ValidationIssue
+
+	if cfg.ChainID == "" {
+		issues = append(issues, ValidationIssue{Key: "chain-id", Severity: SeverityError, Message: "must not be empty"})
+	}
+
+	if u, err := url.Parse(cfg.Node); err != nil || u.Scheme == "" {
+		issues = append(issues, ValidationIssue{Key: "node", Severity: SeverityError, Message: fmt.Sprintf("invalid node address %q, expected <scheme>://<host>:<port>", cfg.Node)})
+	}
+
+	return issues
+}
@@ -0,0 +1,86 @@
+package confix_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/tools/confix"
+	"gotest.tools/v3/assert"
+)
+
+func TestValidate(t *testing.T) {
+	_, err := confix.Validate("foo", []byte{}, false)
+	assert.ErrorContains(t, err, "unknown config")
+
+	issues, err := confix.Validate("app.toml", mustReadConfig(t, "data/v0.48-app.toml"), false)
+	assert.NilError(t, err)
+	assert.Assert(t, !confix.HasErrors(issues))
+
+	// the wasm section is not part of the SDK config
+	issues, err = confix.Validate("app.toml", mustReadConfig(t, "data/v0.48-app.toml"), true)
+	assert.NilError(t, err)
+	assert.Assert(t, confix.HasErrors(issues))
+
+	issues, err = confix.Validate("client.toml", mustReadConfig(t, "testdata/client.toml"), true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(issues), 0)
+
+	testCases := []struct {
+		name   string
+		file   string
+		config string
+		expKey string
+	}{
+		{"unknown key", "app.toml", "minimum-gas-prices = \"0stake\"\npruning-keep-evry = \"0\"", "pruning-keep-evry"},
+		{"invalid type", "app.toml", "minimum-gas-prices = \"0stake\"\n[api]\nenable = \"yes\"", "api.enable"},
+		{"invalid enum", "app.toml", "minimum-gas-prices = \"0stake\"\npruning = \"sometimes\"", "pruning"},
+		{"invalid gas prices", "app.toml", "minimum-gas-prices = \"stake\"", "minimum-gas-prices"},
+		{"invalid custom pruning", "app.toml", "minimum-gas-prices = \"0stake\"\npruning = \"custom\"\npruning-keep-recent = \"100\"\npruning-interval = \"0\"", "pruning"},
+		{"snapshots with pruning everything", "app.toml", "minimum-gas-prices = \"0stake\"\npruning = \"everything\"\n[state-sync]\nsnapshot-interval = 100", "state-sync.snapshot-interval"},
+		{"streaming without plugin", "app.toml", "minimum-gas-prices = \"0stake\"\n[streaming.abci]\nkeys = [\"*\"]", "streaming.abci.plugin"},
+		{"invalid keyring backend", "client.toml", "chain-id = \"foo\"\nkeyring-backend = \"paper\"", "keyring-backend"},
+		{"empty chain-id", "client.toml", "chain-id = \"\"", "chain-id"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issues, err := confix.Validate(tc.file, []byte(tc.config), true)
+			assert.NilError(t, err)
+			assert.Assert(t, confix.HasErrors(issues))
+
+			var keys []string
+			for _, issue := range issues {
+				if issue.Severity == confix.SeverityError {
+					keys = append(keys, issue.Key)
+				}
+			}
+			assert.DeepEqual(t, keys, []string{tc.expKey})
+		})
+	}
+}
+
+func TestValidateCustomPruningWithSnapshots(t *testing.T) {
+	config := "minimum-gas-prices = \"0stake\"\npruning = \"custom\"\npruning-keep-recent = \"100\"\npruning-interval = \"10\"\n[state-sync]\nsnapshot-interval = 1000"
+	issues, err := confix.Validate("app.toml", []byte(config), true)
+	assert.NilError(t, err)
+	assert.Assert(t, !confix.HasErrors(issues))
+	assert.Equal(t, len(issues), 1)
+	assert.Equal(t, issues[0].Key, "pruning-keep-recent")
+	assert.Equal(t, issues[0].Severity, confix.SeverityWarning)
+}
+
+func TestNonDefaultValues(t *testing.T) {
+	kvs, err := confix.NonDefaultValues("app.toml", mustReadConfig(t, "data/v0.48-app.toml"))
+	assert.NilError(t, err)
+
+	var keys []string
+	for _, kv := range kvs {
+		keys = append(keys, kv.Key)
+	}
+	assert.DeepEqual(t, keys, []string{"minimum-gas-prices", "wasm.query_gas_limit", "wasm.lru_size"})
+
+	kvs, err = confix.NonDefaultValues("client.toml", mustReadConfig(t, "testdata/client.toml"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(kvs), 2)
+	assert.Equal(t, kvs[0].Key, "chain-id")
+	assert.Equal(t, kvs[1].Key, "keyring-backend")
+}
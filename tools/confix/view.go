@@ -0,0 +1,57 @@
+package confix
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/creachadair/tomledit"
+	"github.com/spf13/viper"
+)
+
+// NonDefaultValues returns the settings of the configuration file fileName,
+// with the given content, whose value differs from the default value of the
+// installed Cosmos SDK version. Keys unknown to the SDK are always returned.
+// The settings are returned in the order of the file.
+func NonDefaultValues(fileName string, data []byte) ([]KV, error) {
+	schema, err := SchemaFor(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := tomledit.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	var kvs []KV
+	collect := func(s *tomledit.Section) {
+		for _, kv := range allKVs(s) {
+			if field, ok := schema.Lookup(kv.Key); ok && field.IsDefault(v.Get(kv.Key)) {
+				continue
+			}
+
+			kvs = append(kvs, kv)
+		}
+	}
+
+	collect(doc.Global)
+	for _, s := range doc.Sections {
+		collect(s)
+	}
+
+	return kvs, nil
+}
+
+// PrintKVs prints one key = value line per setting.
+func PrintKVs(w io.Writer, kvs []KV) {
+	for _, kv := range kvs {
+		fmt.Fprintf(w, "%s = %s\n", kv.Key, kv.Value)
+	}
+}
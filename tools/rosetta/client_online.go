@@ -81,12 +81,12 @@ func NewClient(cfg *Config) (*Client, error) {
 		}
 	}
 
-	supportedOperations = append(
-		supportedOperations,
-		bank.EventTypeCoinSpent,
-		bank.EventTypeCoinReceived,
-		bank.EventTypeCoinBurn,
-	)
+	mappers := cfg.OperationMappers
+	if mappers == nil {
+		mappers = DefaultOperationMappers()
+	}
+
+	supportedOperations = append(supportedOperations, mappers.EventTypes()...)
 
 	return &Client{
 		supportedOperations: supportedOperations,
@@ -95,7 +95,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank:                nil,
 		tmRPC:               nil,
 		version:             fmt.Sprintf("%s/%s", info.AppName, v),
-		converter:           NewConverter(cfg.Codec, cfg.InterfaceRegistry, txConfig),
+		converter:           NewConverterWithMappers(cfg.Codec, cfg.InterfaceRegistry, txConfig, mappers),
 	}, nil
 }
 
@@ -5,7 +5,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingcodec "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	bankcodec "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrcodec "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingcodec "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // MakeCodec generates the codec required to interact
@@ -17,6 +20,9 @@ func MakeCodec() (*codec.ProtoCodec, codectypes.InterfaceRegistry) {
 	authcodec.RegisterInterfaces(ir)
 	bankcodec.RegisterInterfaces(ir)
 	cryptocodec.RegisterInterfaces(ir)
+	stakingcodec.RegisterInterfaces(ir)
+	distrcodec.RegisterInterfaces(ir)
+	vestingcodec.RegisterInterfaces(ir)
 
 	return cdc, ir
 }
@@ -89,6 +89,9 @@ type Config struct {
 	Codec *codec.ProtoCodec
 	// InterfaceRegistry overrides the default data and construction api interface registry
 	InterfaceRegistry codectypes.InterfaceRegistry
+	// OperationMappers overrides the default mappers converting messages
+	// and events to rosetta operations and back
+	OperationMappers *OperationMappers
 }
 
 // NetworkIdentifier returns the network identifier given the configuration
@@ -115,6 +118,9 @@ func (c *Config) validate() error {
 	if c.Retries == 0 {
 		c.Retries = DefaultRetries
 	}
+	if c.OperationMappers == nil {
+		c.OperationMappers = DefaultOperationMappers()
+	}
 	// these are must
 	if c.Network == "" {
 		return fmt.Errorf("network not provided")
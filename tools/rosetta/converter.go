@@ -105,9 +105,17 @@ type converter struct {
 	bytesToSign     func(tx authsigning.Tx, signerData authsigning.SignerData) (b []byte, err error)
 	ir              codectypes.InterfaceRegistry
 	cdc             *codec.ProtoCodec
+	mappers         *OperationMappers
 }
 
+// NewConverter returns a Converter using the default operation mappers.
 func NewConverter(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sdkclient.TxConfig) Converter {
+	return NewConverterWithMappers(cdc, ir, cfg, DefaultOperationMappers())
+}
+
+// NewConverterWithMappers returns a Converter using the given operation mappers
+// to convert messages and events to rosetta operations and back.
+func NewConverterWithMappers(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sdkclient.TxConfig, mappers *OperationMappers) Converter {
 	return converter{
 		newTxBuilder:    cfg.NewTxBuilder,
 		txBuilderFromTx: cfg.WrapTxBuilder,
@@ -121,8 +129,9 @@ func NewConverter(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sd
 
 			return crypto.Sha256(bytesToSign), nil
 		},
-		ir:  ir,
-		cdc: cdc,
+		ir:      ir,
+		cdc:     cdc,
+		mappers: mappers,
 	}
 }
 
@@ -143,6 +152,27 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 	for i := 0; i < len(ops); i++ {
 		op := ops[i]
 
+		// messages with a registered mapper are built from the operation
+		// and the following operations related to it
+		if mapper, ok := c.mappers.MsgMapper(op.Type); ok {
+			related := relatedOperations(ops[i:])
+			msg, err := mapper.Msg(ops[i : i+related+1])
+			if err != nil {
+				return nil, err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return nil, crgerrs.WrapError(
+					crgerrs.ErrBadArgument,
+					fmt.Sprintf("validation of operation at index %d failed: %s", i, err),
+				)
+			}
+
+			msgs = append(msgs, msg)
+			i += related
+			continue
+		}
+
 		protoMessage, err := c.ir.Resolve(op.Type)
 		if err != nil {
 			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation not found: "+op.Type)
@@ -232,12 +262,17 @@ func (c converter) Meta(msg sdk.Msg) (meta map[string]interface{}, err error) {
 	return
 }
 
-// Ops will create an operation for each msg signer
+// Ops converts msg to operations using its registered mapper, if any.
+// Otherwise it will create an operation for each msg signer
 // with the message proto name as type, and the raw fields
 // as metadata
 func (c converter) Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
 	opName := sdk.MsgTypeURL(msg)
 
+	if mapper, ok := c.mappers.MsgMapper(opName); ok {
+		return mapper.Ops(status, msg)
+	}
+
 	meta, err := c.Meta(msg)
 	if err != nil {
 		return nil, err
@@ -283,13 +318,26 @@ func (c converter) Tx(rawTx cmttypes.Tx, txResult *abci.ResponseDeliverTx) (*ros
 	}
 	// get operations from msgs
 	msgs := tx.GetMsgs()
-	var rawTxOps []*rosettatypes.Operation
+	var (
+		rawTxOps []*rosettatypes.Operation
+		mapped   [][]*rosettatypes.Operation
+	)
 
 	for _, msg := range msgs {
 		ops, err := c.Ops(status, msg)
 		if err != nil {
 			return nil, err
 		}
+
+		if _, ok := c.mappers.MsgMapper(sdk.MsgTypeURL(msg)); ok {
+			// balance changes of executed txs are reported by the balance
+			// operations, keep the intended amounts as metadata only
+			if txResult != nil {
+				moveAmountsToMetadata(ops)
+			}
+			mapped = append(mapped, ops)
+		}
+
 		rawTxOps = append(rawTxOps, ops...)
 	}
 
@@ -302,6 +350,7 @@ func (c converter) Tx(rawTx cmttypes.Tx, txResult *abci.ResponseDeliverTx) (*ros
 
 	// now normalize indexes
 	totalOps := AddOperationIndexes(rawTxOps, balanceOps)
+	linkRelatedOperations(mapped)
 
 	return &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: fmt.Sprintf("%X", rawTx.Hash())},
@@ -313,7 +362,12 @@ func (c converter) BalanceOps(status string, events []abci.Event) []*rosettatype
 	var ops []*rosettatypes.Operation
 
 	for _, e := range events {
-		balanceOps, ok := sdkEventToBalanceOperations(status, e)
+		mapper, ok := c.mappers.EventMapper(e.Type)
+		if !ok {
+			continue
+		}
+
+		balanceOps, ok := mapper(status, e)
 		if !ok {
 			continue
 		}
@@ -323,6 +377,25 @@ func (c converter) BalanceOps(status string, events []abci.Event) []*rosettatype
 	return ops
 }
 
+// moveAmountsToMetadata removes the amounts of the given operations and
+// stores them in their metadata instead.
+func moveAmountsToMetadata(ops []*rosettatypes.Operation) {
+	for _, op := range ops {
+		if op.Amount == nil {
+			continue
+		}
+
+		meta := make(map[string]interface{}, len(op.Metadata)+1)
+		for k, v := range op.Metadata {
+			meta[k] = v
+		}
+		meta[MetaAmount] = op.Amount.Value + op.Amount.Currency.Symbol
+
+		op.Metadata = meta
+		op.Amount = nil
+	}
+}
+
 // sdkEventToBalanceOperations converts an event to a rosetta balance operation
 // it will panic if the event is malformed because it might mean the sdk spec
 // has changed and rosetta needs to reflect those changes too.
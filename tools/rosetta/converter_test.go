@@ -18,7 +18,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type ConverterTestSuite struct {
@@ -334,6 +337,135 @@ func (s *ConverterTestSuite) TestBalanceOps() {
 	})
 }
 
+func (s *ConverterTestSuite) TestMappedMsgsRoundTrip() {
+	delegator := sdk.AccAddress("delegator")
+	validator := sdk.ValAddress("validator")
+	recipient := sdk.AccAddress("recipient")
+
+	msgs := []sdk.Msg{
+		&staking.MsgDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.String(),
+			Amount:           sdk.NewInt64Coin("stake", 10),
+		},
+		&staking.MsgUndelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.String(),
+			Amount:           sdk.NewInt64Coin("stake", 5),
+		},
+		&distribution.MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.String(),
+		},
+		&vesting.MsgCreateVestingAccount{
+			FromAddress: delegator.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("utxo", 1)),
+			EndTime:     1000,
+		},
+		&vesting.MsgCreatePeriodicVestingAccount{
+			FromAddress: delegator.String(),
+			ToAddress:   recipient.String(),
+			StartTime:   10,
+			VestingPeriods: []vesting.Period{
+				{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 3))},
+				{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 7))},
+			},
+		},
+	}
+
+	builder := s.txConf.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(msgs...))
+	txBytes, err := s.txConf.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	ops, _, err := s.c.ToRosetta().OpsAndSigners(txBytes)
+	s.Require().NoError(err)
+	// delegate: 1, undelegate: 1, withdraw: 1, vesting: 2 denoms * 2 accounts, periodic vesting: 2
+	s.Require().Len(ops, 9)
+
+	// construction operations carry the amounts
+	s.Require().Equal(delegator.String(), ops[0].Account.Address)
+	s.Require().Equal("-10", ops[0].Amount.Value)
+	s.Require().Nil(ops[1].Amount)
+	s.Require().Equal("5stake", ops[1].Metadata[rosetta.MetaAmount])
+
+	// operations of a single msg are related to the first one
+	s.Require().Nil(ops[3].RelatedOperations)
+	for _, op := range ops[4:7] {
+		s.Require().Equal(ops[3].OperationIdentifier.Index, op.RelatedOperations[0].Index)
+	}
+
+	// operations go through json in the rosetta API
+	bz, err := json.Marshal(ops)
+	s.Require().NoError(err)
+	var decodedOps []*rosettatypes.Operation
+	s.Require().NoError(json.Unmarshal(bz, &decodedOps))
+
+	tx, err := s.c.ToSDK().UnsignedTx(decodedOps)
+	s.Require().NoError(err)
+	s.Require().Equal(msgs, tx.GetMsgs())
+}
+
+func (s *ConverterTestSuite) TestMappedMsgsExecutedTx() {
+	msg := &staking.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress("delegator").String(),
+		ValidatorAddress: sdk.ValAddress("validator").String(),
+		Amount:           sdk.NewInt64Coin("stake", 10),
+	}
+
+	builder := s.txConf.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(msg))
+	txBytes, err := s.txConf.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	spent := bank.NewCoinSpentEvent(sdk.AccAddress("delegator"), sdk.NewCoins(msg.Amount))
+	tx, err := s.c.ToRosetta().Tx(txBytes, &abci.ResponseDeliverTx{Events: []abci.Event{abci.Event(spent)}})
+	s.Require().NoError(err)
+	s.Require().Len(tx.Operations, 2)
+
+	// the balance change is only reported by the event operation
+	s.Require().Nil(tx.Operations[0].Amount)
+	s.Require().Equal("-10stake", tx.Operations[0].Metadata[rosetta.MetaAmount])
+	s.Require().Equal("-10", tx.Operations[1].Amount.Value)
+}
+
+func (s *ConverterTestSuite) TestCustomOperationMappers() {
+	mappers := rosetta.NewOperationMappers()
+	mappers.RegisterEventMapper("custom_transfer", func(status string, event abci.Event) ([]*rosettatypes.Operation, bool) {
+		return []*rosettatypes.Operation{{
+			Type:    event.Type,
+			Status:  &status,
+			Account: &rosettatypes.AccountIdentifier{Address: event.Attributes[0].Value},
+			Amount:  &rosettatypes.Amount{Value: "1", Currency: &rosettatypes.Currency{Symbol: "stake"}},
+		}}, true
+	})
+
+	c := rosetta.NewConverterWithMappers(s.cdc, s.ir, s.txConf, mappers)
+	s.Require().Equal([]string{"custom_transfer"}, mappers.EventTypes())
+
+	events := []abci.Event{
+		{Type: "custom_transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "addr"}}},
+		abci.Event(bank.NewCoinSpentEvent(sdk.AccAddress("test"), sdk.NewCoins(sdk.NewInt64Coin("test", 10)))),
+	}
+
+	ops := c.ToRosetta().BalanceOps("", events)
+	s.Require().Len(ops, 1)
+	s.Require().Equal("addr", ops[0].Account.Address)
+
+	// without a msg mapper, messages are represented by their raw fields
+	msg := &staking.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress("delegator").String(),
+		ValidatorAddress: sdk.ValAddress("validator").String(),
+		Amount:           sdk.NewInt64Coin("stake", 10),
+	}
+	msgOps, err := c.ToRosetta().Ops("", msg)
+	s.Require().NoError(err)
+	s.Require().Len(msgOps, 1)
+	s.Require().Nil(msgOps[0].Amount)
+	s.Require().Equal(msg.ValidatorAddress, msgOps[0].Metadata["validator_address"])
+}
+
 func TestConverterTestSuite(t *testing.T) {
 	suite.Run(t, new(ConverterTestSuite))
 }
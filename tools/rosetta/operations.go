@@ -0,0 +1,257 @@
+package rosetta
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MsgOperationMapper converts a sdk.Msg type to rosetta operations and back.
+// It allows to represent a message with operations carrying the accounts and
+// amounts involved, instead of the raw message fields only.
+//
+// The operations returned by Ops carry the amount of the liquid balance change
+// the message causes for each account. As balance changes of executed
+// transactions are already reported by event operations, the converter moves
+// these amounts to the operation metadata when the transaction result is known.
+type MsgOperationMapper interface {
+	// Ops returns the operations representing msg.
+	Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error)
+	// Msg builds the sdk.Msg represented by ops. The first operation is the
+	// one returned first by Ops, the following ones are related to it.
+	Msg(ops []*rosettatypes.Operation) (sdk.Msg, error)
+}
+
+// EventOperationMapper converts an ABCI event to rosetta balance operations.
+// It returns false if the event does not describe a balance change.
+// It should panic if the event is malformed, as it means the spec of the
+// event changed and rosetta needs to reflect those changes too.
+type EventOperationMapper func(status string, event abci.Event) ([]*rosettatypes.Operation, bool)
+
+// msgOperationMapper adapts functions to a MsgOperationMapper.
+type msgOperationMapper struct {
+	ops func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error)
+	msg func(ops []*rosettatypes.Operation) (sdk.Msg, error)
+}
+
+// NewMsgOperationMapper returns a MsgOperationMapper from its conversion functions.
+func NewMsgOperationMapper(
+	ops func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error),
+	msg func(ops []*rosettatypes.Operation) (sdk.Msg, error),
+) MsgOperationMapper {
+	return msgOperationMapper{ops: ops, msg: msg}
+}
+
+func (m msgOperationMapper) Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+	return m.ops(status, msg)
+}
+
+func (m msgOperationMapper) Msg(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+	return m.msg(ops)
+}
+
+// OperationMappers is the registry of the operation mappers used by the
+// converter, keyed by message type URL and event type respectively.
+// Messages without a registered mapper are represented by one operation per
+// signer with the message fields as metadata.
+type OperationMappers struct {
+	msgs   map[string]MsgOperationMapper
+	events map[string]EventOperationMapper
+}
+
+// NewOperationMappers returns an empty operation mappers registry.
+func NewOperationMappers() *OperationMappers {
+	return &OperationMappers{
+		msgs:   make(map[string]MsgOperationMapper),
+		events: make(map[string]EventOperationMapper),
+	}
+}
+
+// DefaultOperationMappers returns the operation mappers registry with the
+// x/bank balance events and the x/staking, x/distribution and x/auth/vesting
+// messages registered.
+func DefaultOperationMappers() *OperationMappers {
+	m := NewOperationMappers()
+
+	m.RegisterEventMapper(banktypes.EventTypeCoinSpent, sdkEventToBalanceOperations)
+	m.RegisterEventMapper(banktypes.EventTypeCoinReceived, sdkEventToBalanceOperations)
+	m.RegisterEventMapper(banktypes.EventTypeCoinBurn, sdkEventToBalanceOperations)
+
+	registerStakingMappers(m)
+	registerDistributionMappers(m)
+	registerVestingMappers(m)
+
+	return m
+}
+
+// RegisterMsgMapper registers the operation mapper of the type of msg,
+// replacing any previously registered one.
+func (m *OperationMappers) RegisterMsgMapper(msg sdk.Msg, mapper MsgOperationMapper) {
+	m.msgs[sdk.MsgTypeURL(msg)] = mapper
+}
+
+// RegisterEventMapper registers the operation mapper of the given event type,
+// replacing any previously registered one.
+func (m *OperationMappers) RegisterEventMapper(eventType string, mapper EventOperationMapper) {
+	m.events[eventType] = mapper
+}
+
+// MsgMapper returns the operation mapper registered for the given message
+// type URL, i.e. the operation type.
+func (m *OperationMappers) MsgMapper(typeURL string) (MsgOperationMapper, bool) {
+	mapper, ok := m.msgs[typeURL]
+	return mapper, ok
+}
+
+// EventMapper returns the operation mapper registered for the given event type.
+func (m *OperationMappers) EventMapper(eventType string) (EventOperationMapper, bool) {
+	mapper, ok := m.events[eventType]
+	return mapper, ok
+}
+
+// EventTypes returns the sorted event types with a registered mapper.
+func (m *OperationMappers) EventTypes() []string {
+	types := make([]string, 0, len(m.events))
+	for typ := range m.events {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// coinsOps returns one operation per coin moved from or to address.
+func coinsOps(status, opType, address string, coins sdk.Coins, negative bool, meta map[string]interface{}) []*rosettatypes.Operation {
+	ops := make([]*rosettatypes.Operation, len(coins))
+	for i, coin := range coins {
+		value := coin.Amount.String()
+		if negative {
+			value = "-" + value
+		}
+
+		ops[i] = &rosettatypes.Operation{
+			Type:    opType,
+			Status:  &status,
+			Account: &rosettatypes.AccountIdentifier{Address: address},
+			Amount: &rosettatypes.Amount{
+				Value:    value,
+				Currency: &rosettatypes.Currency{Symbol: coin.Denom},
+			},
+			Metadata: meta,
+		}
+	}
+
+	return ops
+}
+
+// accountOp returns an operation without amount for address.
+func accountOp(status, opType, address string, meta map[string]interface{}) *rosettatypes.Operation {
+	return &rosettatypes.Operation{
+		Type:     opType,
+		Status:   &status,
+		Account:  &rosettatypes.AccountIdentifier{Address: address},
+		Metadata: meta,
+	}
+}
+
+// opsCoins returns the coins moved by the operations of address. Operations
+// must all be negative or all positive, as specified by negative.
+func opsCoins(ops []*rosettatypes.Operation, address string, negative bool) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, op := range ops {
+		if op.Account == nil || op.Account.Address != address || op.Amount == nil {
+			continue
+		}
+
+		if op.Amount.Currency == nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation amount has no currency")
+		}
+
+		value := op.Amount.Value
+		if strings.HasPrefix(value, "-") != negative {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("unexpected sign of amount %s for account %s", value, address))
+		}
+
+		amount, ok := sdk.NewIntFromString(strings.TrimPrefix(value, "-"))
+		if !ok {
+			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "invalid amount "+value)
+		}
+
+		coins = coins.Add(sdk.NewCoin(op.Amount.Currency.Symbol, amount))
+	}
+
+	return coins, nil
+}
+
+// opAccount returns the address of the account of the operation.
+func opAccount(op *rosettatypes.Operation) (string, error) {
+	if op.Account == nil || op.Account.Address == "" {
+		return "", crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("operation %s has no account", op.Type))
+	}
+
+	return op.Account.Address, nil
+}
+
+// opMetaString returns a string metadata value of the operation.
+func opMetaString(op *rosettatypes.Operation, key string) (string, error) {
+	v, ok := op.Metadata[key]
+	if !ok {
+		return "", crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("operation %s is missing metadata %s", op.Type, key))
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("operation %s metadata %s must be a string", op.Type, key))
+	}
+
+	return s, nil
+}
+
+// linkRelatedOperations marks the operations of a message as related to the
+// first one, once the operations have been indexed.
+func linkRelatedOperations(groups [][]*rosettatypes.Operation) {
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+
+		first := group[0].OperationIdentifier
+		for _, op := range group[1:] {
+			op.RelatedOperations = []*rosettatypes.OperationIdentifier{{Index: first.Index}}
+		}
+	}
+}
+
+// relatedOperations returns the number of operations following ops[0] which
+// are related to it.
+func relatedOperations(ops []*rosettatypes.Operation) int {
+	first := ops[0].OperationIdentifier
+	if first == nil {
+		return 0
+	}
+
+	n := 0
+	for _, op := range ops[1:] {
+		related := false
+		for _, rel := range op.RelatedOperations {
+			if rel != nil && rel.Index == first.Index {
+				related = true
+				break
+			}
+		}
+
+		if !related {
+			break
+		}
+		n++
+	}
+
+	return n
+}
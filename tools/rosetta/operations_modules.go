@@ -0,0 +1,418 @@
+package rosetta
+
+import (
+	"fmt"
+
+	crgerrs "cosmossdk.io/tools/rosetta/lib/errors"
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// operation metadata keys used by the default message mappers
+const (
+	MetaValidatorAddress    = "validator_address"
+	MetaValidatorSrcAddress = "validator_src_address"
+	MetaValidatorDstAddress = "validator_dst_address"
+	MetaWithdrawAddress     = "withdraw_address"
+	MetaAmount              = "amount"
+	MetaStartTime           = "start_time"
+	MetaEndTime             = "end_time"
+	MetaDelayed             = "delayed"
+	MetaVestingPeriods      = "vesting_periods"
+)
+
+// registerStakingMappers registers the x/staking message mappers.
+// Delegations move funds from the delegator, undelegations and redelegations
+// do not change liquid balances when executed, so their amount is metadata.
+func registerStakingMappers(m *OperationMappers) {
+	m.RegisterMsgMapper(&stakingtypes.MsgDelegate{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			delegate := msg.(*stakingtypes.MsgDelegate)
+			meta := map[string]interface{}{MetaValidatorAddress: delegate.ValidatorAddress}
+			return coinsOps(status, sdk.MsgTypeURL(msg), delegate.DelegatorAddress, sdk.NewCoins(delegate.Amount), true, meta), nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			delegator, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			validator, err := opMetaString(ops[0], MetaValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := singleCoin(ops, delegator)
+			if err != nil {
+				return nil, err
+			}
+
+			return &stakingtypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: amount}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&stakingtypes.MsgUndelegate{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			undelegate := msg.(*stakingtypes.MsgUndelegate)
+			meta := map[string]interface{}{
+				MetaValidatorAddress: undelegate.ValidatorAddress,
+				MetaAmount:           undelegate.Amount.String(),
+			}
+			return []*rosettatypes.Operation{accountOp(status, sdk.MsgTypeURL(msg), undelegate.DelegatorAddress, meta)}, nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			delegator, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			validator, err := opMetaString(ops[0], MetaValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := opMetaCoin(ops[0], MetaAmount)
+			if err != nil {
+				return nil, err
+			}
+
+			return &stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: amount}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&stakingtypes.MsgBeginRedelegate{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			redelegate := msg.(*stakingtypes.MsgBeginRedelegate)
+			meta := map[string]interface{}{
+				MetaValidatorSrcAddress: redelegate.ValidatorSrcAddress,
+				MetaValidatorDstAddress: redelegate.ValidatorDstAddress,
+				MetaAmount:              redelegate.Amount.String(),
+			}
+			return []*rosettatypes.Operation{accountOp(status, sdk.MsgTypeURL(msg), redelegate.DelegatorAddress, meta)}, nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			delegator, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			src, err := opMetaString(ops[0], MetaValidatorSrcAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			dst, err := opMetaString(ops[0], MetaValidatorDstAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := opMetaCoin(ops[0], MetaAmount)
+			if err != nil {
+				return nil, err
+			}
+
+			return &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    delegator,
+				ValidatorSrcAddress: src,
+				ValidatorDstAddress: dst,
+				Amount:              amount,
+			}, nil
+		},
+	))
+}
+
+// registerDistributionMappers registers the x/distribution message mappers.
+// Withdrawn rewards and commissions are only known once executed, they are
+// reported by the balance event operations.
+func registerDistributionMappers(m *OperationMappers) {
+	m.RegisterMsgMapper(&distrtypes.MsgWithdrawDelegatorReward{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			withdraw := msg.(*distrtypes.MsgWithdrawDelegatorReward)
+			meta := map[string]interface{}{MetaValidatorAddress: withdraw.ValidatorAddress}
+			return []*rosettatypes.Operation{accountOp(status, sdk.MsgTypeURL(msg), withdraw.DelegatorAddress, meta)}, nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			delegator, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			validator, err := opMetaString(ops[0], MetaValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			return &distrtypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&distrtypes.MsgWithdrawValidatorCommission{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			withdraw := msg.(*distrtypes.MsgWithdrawValidatorCommission)
+			valAddr, err := sdk.ValAddressFromBech32(withdraw.ValidatorAddress)
+			if err != nil {
+				return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+			}
+
+			meta := map[string]interface{}{MetaValidatorAddress: withdraw.ValidatorAddress}
+			return []*rosettatypes.Operation{accountOp(status, sdk.MsgTypeURL(msg), sdk.AccAddress(valAddr).String(), meta)}, nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			validator, err := opMetaString(ops[0], MetaValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			return &distrtypes.MsgWithdrawValidatorCommission{ValidatorAddress: validator}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&distrtypes.MsgSetWithdrawAddress{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			set := msg.(*distrtypes.MsgSetWithdrawAddress)
+			meta := map[string]interface{}{MetaWithdrawAddress: set.WithdrawAddress}
+			return []*rosettatypes.Operation{accountOp(status, sdk.MsgTypeURL(msg), set.DelegatorAddress, meta)}, nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			delegator, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			withdrawAddr, err := opMetaString(ops[0], MetaWithdrawAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			return &distrtypes.MsgSetWithdrawAddress{DelegatorAddress: delegator, WithdrawAddress: withdrawAddr}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&distrtypes.MsgFundCommunityPool{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			fund := msg.(*distrtypes.MsgFundCommunityPool)
+			return coinsOps(status, sdk.MsgTypeURL(msg), fund.Depositor, fund.Amount, true, nil), nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			depositor, err := opAccount(ops[0])
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := opsCoins(ops, depositor, true)
+			if err != nil {
+				return nil, err
+			}
+
+			return &distrtypes.MsgFundCommunityPool{Depositor: depositor, Amount: amount}, nil
+		},
+	))
+}
+
+// registerVestingMappers registers the x/auth/vesting message mappers.
+// Creating a vesting account moves the funds from the sender to the new
+// account, the locked amount unlocks over time without balance change.
+func registerVestingMappers(m *OperationMappers) {
+	m.RegisterMsgMapper(&vestingtypes.MsgCreateVestingAccount{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			create := msg.(*vestingtypes.MsgCreateVestingAccount)
+			meta := map[string]interface{}{MetaEndTime: create.EndTime, MetaDelayed: create.Delayed}
+			return transferOps(status, sdk.MsgTypeURL(msg), create.FromAddress, create.ToAddress, create.Amount, meta), nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			from, to, amount, err := opsTransfer(ops)
+			if err != nil {
+				return nil, err
+			}
+
+			endTime, err := opMetaInt(ops[0], MetaEndTime)
+			if err != nil {
+				return nil, err
+			}
+
+			delayed, _ := ops[0].Metadata[MetaDelayed].(bool)
+
+			return &vestingtypes.MsgCreateVestingAccount{
+				FromAddress: from,
+				ToAddress:   to,
+				Amount:      amount,
+				EndTime:     endTime,
+				Delayed:     delayed,
+			}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&vestingtypes.MsgCreatePermanentLockedAccount{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			create := msg.(*vestingtypes.MsgCreatePermanentLockedAccount)
+			return transferOps(status, sdk.MsgTypeURL(msg), create.FromAddress, create.ToAddress, create.Amount, nil), nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			from, to, amount, err := opsTransfer(ops)
+			if err != nil {
+				return nil, err
+			}
+
+			return &vestingtypes.MsgCreatePermanentLockedAccount{FromAddress: from, ToAddress: to, Amount: amount}, nil
+		},
+	))
+
+	m.RegisterMsgMapper(&vestingtypes.MsgCreatePeriodicVestingAccount{}, NewMsgOperationMapper(
+		func(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+			create := msg.(*vestingtypes.MsgCreatePeriodicVestingAccount)
+
+			periods := make([]interface{}, len(create.VestingPeriods))
+			total := sdk.NewCoins()
+			for i, period := range create.VestingPeriods {
+				periods[i] = map[string]interface{}{"length": period.Length, MetaAmount: period.Amount.String()}
+				total = total.Add(period.Amount...)
+			}
+
+			meta := map[string]interface{}{MetaStartTime: create.StartTime, MetaVestingPeriods: periods}
+			return transferOps(status, sdk.MsgTypeURL(msg), create.FromAddress, create.ToAddress, total, meta), nil
+		},
+		func(ops []*rosettatypes.Operation) (sdk.Msg, error) {
+			from, to, amount, err := opsTransfer(ops)
+			if err != nil {
+				return nil, err
+			}
+
+			startTime, err := opMetaInt(ops[0], MetaStartTime)
+			if err != nil {
+				return nil, err
+			}
+
+			rawPeriods, ok := ops[0].Metadata[MetaVestingPeriods].([]interface{})
+			if !ok {
+				return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation is missing metadata "+MetaVestingPeriods)
+			}
+
+			periods := make([]vestingtypes.Period, len(rawPeriods))
+			total := sdk.NewCoins()
+			for i, raw := range rawPeriods {
+				period, ok := raw.(map[string]interface{})
+				if !ok {
+					return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid vesting period %d", i))
+				}
+
+				op := &rosettatypes.Operation{Type: ops[0].Type, Metadata: period}
+				length, err := opMetaInt(op, "length")
+				if err != nil {
+					return nil, err
+				}
+
+				coinsStr, err := opMetaString(op, MetaAmount)
+				if err != nil {
+					return nil, err
+				}
+
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+				}
+
+				periods[i] = vestingtypes.Period{Length: length, Amount: coins}
+				total = total.Add(coins...)
+			}
+
+			if !total.Equal(amount) {
+				return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("vesting periods total %s does not match the operations amount %s", total, amount))
+			}
+
+			return &vestingtypes.MsgCreatePeriodicVestingAccount{
+				FromAddress:    from,
+				ToAddress:      to,
+				StartTime:      startTime,
+				VestingPeriods: periods,
+			}, nil
+		},
+	))
+}
+
+// transferOps returns the operations moving coins from an account to another.
+func transferOps(status, opType, from, to string, coins sdk.Coins, meta map[string]interface{}) []*rosettatypes.Operation {
+	ops := coinsOps(status, opType, from, coins, true, meta)
+	return append(ops, coinsOps(status, opType, to, coins, false, meta)...)
+}
+
+// opsTransfer returns the sender, recipient and amount of transfer operations.
+func opsTransfer(ops []*rosettatypes.Operation) (from, to string, amount sdk.Coins, err error) {
+	from, err = opAccount(ops[0])
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	for _, op := range ops {
+		if op.Account != nil && op.Account.Address != from {
+			to = op.Account.Address
+			break
+		}
+	}
+	if to == "" {
+		return "", "", nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "transfer operations have no recipient")
+	}
+
+	sent, err := opsCoins(ops, from, true)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	received, err := opsCoins(ops, to, false)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	if !sent.Equal(received) {
+		return "", "", nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("sent amount %s does not match received amount %s", sent, received))
+	}
+
+	return from, to, sent, nil
+}
+
+// singleCoin returns the single coin moved by the operations of address.
+func singleCoin(ops []*rosettatypes.Operation, address string) (sdk.Coin, error) {
+	coins, err := opsCoins(ops, address, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(coins) != 1 {
+		return sdk.Coin{}, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("expected a single amount, got %s", coins))
+	}
+
+	return coins[0], nil
+}
+
+// opMetaCoin returns a coin metadata value of the operation.
+func opMetaCoin(op *rosettatypes.Operation, key string) (sdk.Coin, error) {
+	s, err := opMetaString(op, key)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	coin, err := sdk.ParseCoinNormalized(s)
+	if err != nil {
+		return sdk.Coin{}, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+	}
+
+	return coin, nil
+}
+
+// opMetaInt returns an integer metadata value of the operation. Metadata
+// decoded from JSON holds numbers as float64.
+func opMetaInt(op *rosettatypes.Operation, key string) (int64, error) {
+	switch v := op.Metadata[key].(type) {
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case nil:
+		return 0, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("operation %s is missing metadata %s", op.Type, key))
+	default:
+		return 0, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("operation %s metadata %s must be an integer", op.Type, key))
+	}
+}
@@ -39,6 +39,8 @@ type Context struct {
 	eventManager         EventManagerI
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
+	storeKVGasConfigs    []StoreGasConfig                // per-store overrides of kvGasConfig
+	storeKVGasConfigMap  map[string]storetypes.GasConfig // storeKVGasConfigs by store key name
 	transientKVGasConfig storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
 }
@@ -66,6 +68,11 @@ func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGas
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 
+// KVGasSchedule returns the gas configurations charged by the KVStores.
+func (c Context) KVGasSchedule() GasSchedule {
+	return GasSchedule{Default: c.kvGasConfig, Stores: c.storeKVGasConfigs}
+}
+
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
 	msg := proto.Clone(&c.header).(*cmtproto.Header)
@@ -203,9 +210,28 @@ func (c Context) WithBlockGasMeter(meter storetypes.GasMeter) Context {
 }
 
 // WithKVGasConfig returns a Context with an updated gas configuration for
-// the KVStore
+// the KVStore. It is charged by every KVStore, the per-store configurations of
+// the KVGasSchedule being cleared.
 func (c Context) WithKVGasConfig(gasConfig storetypes.GasConfig) Context {
 	c.kvGasConfig = gasConfig
+	c.storeKVGasConfigs = nil
+	c.storeKVGasConfigMap = nil
+	return c
+}
+
+// WithKVGasSchedule returns a Context with updated gas configurations for the
+// KVStores. The default configuration of the schedule is the one returned by
+// KVGasConfig.
+func (c Context) WithKVGasSchedule(schedule GasSchedule) Context {
+	c.kvGasConfig = schedule.Default
+	c.storeKVGasConfigs = schedule.Stores
+	c.storeKVGasConfigMap = nil
+	if len(schedule.Stores) > 0 {
+		c.storeKVGasConfigMap = make(map[string]storetypes.GasConfig, len(schedule.Stores))
+		for _, store := range schedule.Stores {
+			c.storeKVGasConfigMap[store.StoreKey] = store.GasConfig
+		}
+	}
 	return c
 }
 
@@ -284,9 +310,15 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore. It is charged the gas
+// configuration of its store key in the KVGasSchedule.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	gasConfig, ok := c.storeKVGasConfigMap[key.Name()]
+	if !ok {
+		gasConfig = c.kvGasConfig
+	}
+
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, gasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
@@ -139,6 +139,9 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrQueryTimeout defines an error when a query exceeds its timeout.
+	ErrQueryTimeout = errorsmod.Register(RootCodespace, 42, "query timeout")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StoreGasConfig is the gas configuration charged by the KVStore of a store key.
+type StoreGasConfig struct {
+	StoreKey  string               `json:"store_key" yaml:"store_key"`
+	GasConfig storetypes.GasConfig `json:"gas_config" yaml:"gas_config"`
+}
+
+// GasSchedule is the registry of the gas configurations charged by the KVStores,
+// keyed by store key name. The stores without an entry are charged the default
+// gas configuration.
+type GasSchedule struct {
+	Default storetypes.GasConfig `json:"default" yaml:"default"`
+	Stores  []StoreGasConfig     `json:"stores" yaml:"stores"`
+}
+
+// DefaultGasSchedule returns a gas schedule charging storetypes.KVGasConfig to
+// every store.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		Default: storetypes.KVGasConfig(),
+	}
+}
+
+// GasConfig returns the gas configuration charged by the KVStore of key.
+func (s GasSchedule) GasConfig(key storetypes.StoreKey) storetypes.GasConfig {
+	name := key.Name()
+	for _, store := range s.Stores {
+		if store.StoreKey == name {
+			return store.GasConfig
+		}
+	}
+
+	return s.Default
+}
+
+// Validate checks that the default gas configuration is set, so that the stores
+// without an entry are not free, and that every store has at most one gas
+// configuration.
+func (s GasSchedule) Validate() error {
+	if s.Default == (storetypes.GasConfig{}) {
+		return fmt.Errorf("gas schedule default gas config cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(s.Stores))
+	for _, store := range s.Stores {
+		if store.StoreKey == "" {
+			return fmt.Errorf("gas schedule store key cannot be empty")
+		}
+		if _, ok := seen[store.StoreKey]; ok {
+			return fmt.Errorf("duplicate gas schedule for store %s", store.StoreKey)
+		}
+		seen[store.StoreKey] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasScheduleGasConfig(t *testing.T) {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	stakingKey := storetypes.NewKVStoreKey("staking")
+
+	staking := storetypes.KVGasConfig()
+	staking.WriteCostFlat *= 10
+
+	schedule := types.DefaultGasSchedule()
+	schedule.Stores = []types.StoreGasConfig{{StoreKey: "staking", GasConfig: staking}}
+
+	require.Equal(t, storetypes.KVGasConfig(), schedule.GasConfig(bankKey))
+	require.Equal(t, staking, schedule.GasConfig(stakingKey))
+}
+
+func TestGasScheduleValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		dflt   storetypes.GasConfig
+		stores []types.StoreGasConfig
+		expErr bool
+	}{
+		{"no stores", storetypes.KVGasConfig(), nil, false},
+		{"distinct stores", storetypes.KVGasConfig(), []types.StoreGasConfig{{StoreKey: "bank"}, {StoreKey: "staking"}}, false},
+		{"empty default", storetypes.GasConfig{}, []types.StoreGasConfig{{StoreKey: "bank"}}, true},
+		{"empty store key", storetypes.KVGasConfig(), []types.StoreGasConfig{{StoreKey: ""}}, true},
+		{"duplicate store", storetypes.KVGasConfig(), []types.StoreGasConfig{{StoreKey: "bank"}, {StoreKey: "bank"}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := types.GasSchedule{Default: tc.dflt, Stores: tc.stores}
+			if tc.expErr {
+				require.Error(t, schedule.Validate())
+			} else {
+				require.NoError(t, schedule.Validate())
+			}
+		})
+	}
+}
+
+func TestContextKVGasSchedule(t *testing.T) {
+	key := storetypes.NewKVStoreKey(t.Name())
+	other := storetypes.NewKVStoreKey(t.Name() + "_other")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+t.Name()))
+	require.Equal(t, types.DefaultGasSchedule(), ctx.KVGasSchedule())
+
+	cheap := storetypes.GasConfig{WriteCostFlat: 1}
+	ctx = ctx.WithKVGasSchedule(types.GasSchedule{
+		Default: storetypes.KVGasConfig(),
+		Stores:  []types.StoreGasConfig{{StoreKey: key.Name(), GasConfig: cheap}},
+	})
+	require.Equal(t, storetypes.KVGasConfig(), ctx.KVGasConfig())
+	require.Equal(t, cheap, ctx.KVGasSchedule().GasConfig(key))
+	require.Equal(t, storetypes.KVGasConfig(), ctx.KVGasSchedule().GasConfig(other))
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Set([]byte("k"), []byte("v"))
+	require.Equal(t, storetypes.Gas(1), ctx.GasMeter().GasConsumed())
+}
+
+func TestContextKVGasConfigClearsSchedule(t *testing.T) {
+	key := storetypes.NewKVStoreKey(t.Name())
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+t.Name()))
+
+	ctx = ctx.WithKVGasSchedule(types.GasSchedule{
+		Default: storetypes.KVGasConfig(),
+		Stores:  []types.StoreGasConfig{{StoreKey: key.Name(), GasConfig: storetypes.GasConfig{WriteCostFlat: 1}}},
+	})
+
+	// a zero gas config makes every store free, including the ones of the schedule
+	ctx = ctx.WithKVGasConfig(storetypes.GasConfig{}).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.Empty(t, ctx.KVGasSchedule().Stores)
+	ctx.KVStore(key).Set([]byte("k"), []byte("v"))
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+}
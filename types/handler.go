@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -45,8 +47,50 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 		chain = append(chain, Terminator{})
 	}
 
+	// The names of the decorators are resolved once per chain and the tracer
+	// once per tx, so that an untraced tx runs the plain chain.
+	names := decoratorNames(chain)
+	handler := chainAnteDecorators(chain)
+
+	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		if tracer, ok := decoratorTracer(ctx); ok {
+			return traceAnteDecorators(chain, names, tracer)(ctx, tx, simulate)
+		}
+
+		return handler(ctx, tx, simulate)
+	}
+}
+
+func chainAnteDecorators(chain []AnteDecorator) AnteHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := chainAnteDecorators(chain[1:])
+	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		return chain[0].AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+// traceAnteDecorators chains the decorators like chainAnteDecorators, reporting
+// their execution to tracer.
+func traceAnteDecorators(chain []AnteDecorator, names []string, tracer DecoratorTracer) AnteHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := traceAnteDecorators(chain[1:], names[1:], tracer)
+	if chain[0] == (Terminator{}) {
+		return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+			return chain[0].AnteHandle(ctx, tx, simulate, next)
+		}
+	}
+
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
-		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		tracer.EnterDecorator(ctx, AnteDecoratorChain, names[0])
+		newCtx, err := chain[0].AnteHandle(ctx, tx, simulate, next)
+		tracer.ExitDecorator(decoratorExitContext(ctx, newCtx), AnteDecoratorChain, names[0], err)
+		return newCtx, err
 	}
 }
 
@@ -68,9 +112,107 @@ func ChainPostDecorators(chain ...PostDecorator) PostHandler {
 		chain = append(chain, Terminator{})
 	}
 
+	names := decoratorNames(chain)
+	handler := chainPostDecorators(chain)
+
+	return func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+		if tracer, ok := decoratorTracer(ctx); ok {
+			return tracePostDecorators(chain, names, tracer)(ctx, tx, simulate, success)
+		}
+
+		return handler(ctx, tx, simulate, success)
+	}
+}
+
+func chainPostDecorators(chain []PostDecorator) PostHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := chainPostDecorators(chain[1:])
 	return func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
-		return chain[0].PostHandle(ctx, tx, simulate, success, ChainPostDecorators(chain[1:]...))
+		return chain[0].PostHandle(ctx, tx, simulate, success, next)
+	}
+}
+
+// tracePostDecorators chains the decorators like chainPostDecorators, reporting
+// their execution to tracer.
+func tracePostDecorators(chain []PostDecorator, names []string, tracer DecoratorTracer) PostHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := tracePostDecorators(chain[1:], names[1:], tracer)
+	if chain[0] == (Terminator{}) {
+		return func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+			return chain[0].PostHandle(ctx, tx, simulate, success, next)
+		}
+	}
+
+	return func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+		tracer.EnterDecorator(ctx, PostDecoratorChain, names[0])
+		newCtx, err := chain[0].PostHandle(ctx, tx, simulate, success, next)
+		tracer.ExitDecorator(decoratorExitContext(ctx, newCtx), PostDecoratorChain, names[0], err)
+		return newCtx, err
+	}
+}
+
+// Decorator chains reported to a DecoratorTracer.
+const (
+	AnteDecoratorChain = "ante"
+	PostDecoratorChain = "post"
+)
+
+// DecoratorTracer is notified of the execution of the decorators chained by
+// ChainAnteDecorators and ChainPostDecorators when it is set in the context
+// with ContextWithDecoratorTracer, e.g. to trace the execution of a tx.
+//
+// A decorator wraps the decorators further along its chain, so their
+// executions are nested between its EnterDecorator and ExitDecorator calls.
+type DecoratorTracer interface {
+	// EnterDecorator is called with the context passed to the decorator,
+	// before it runs. decorator is the name of its type.
+	EnterDecorator(ctx Context, chain, decorator string)
+	// ExitDecorator is called with the context returned by the decorator, or
+	// the context passed to it if none is returned, once it has run.
+	ExitDecorator(ctx Context, chain, decorator string, err error)
+}
+
+type decoratorTracerKey struct{}
+
+// ContextWithDecoratorTracer returns a copy of ctx whose decorator chains
+// report their execution to tracer.
+func ContextWithDecoratorTracer(ctx Context, tracer DecoratorTracer) Context {
+	return ctx.WithValue(decoratorTracerKey{}, tracer)
+}
+
+func decoratorTracer(ctx Context) (DecoratorTracer, bool) {
+	if ctx.baseCtx == nil {
+		return nil, false
 	}
+
+	tracer, ok := ctx.Value(decoratorTracerKey{}).(DecoratorTracer)
+	return tracer, ok
+}
+
+// decoratorNames returns the names of the types of the decorators of chain.
+func decoratorNames[D any](chain []D) []string {
+	names := make([]string, len(chain))
+	for i, decorator := range chain {
+		names[i] = fmt.Sprintf("%T", decorator)
+	}
+
+	return names
+}
+
+// decoratorExitContext returns the context returned by a decorator, or the
+// context passed to it if it returned none, e.g. on error.
+func decoratorExitContext(ctx, newCtx Context) Context {
+	if newCtx.IsZero() {
+		return ctx
+	}
+
+	return newCtx
 }
 
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
@@ -1,11 +1,15 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -64,3 +68,63 @@ func TestChainPostDecorators(t *testing.T) {
 	)(ctx, tx, true, true)
 	require.NoError(t, err)
 }
+
+type testDecorator struct{ err error }
+
+func (d testDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.err != nil {
+		return ctx, d.err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d testDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if d.err != nil {
+		return ctx, d.err
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+type recordingTracer struct {
+	calls []string
+}
+
+func (r *recordingTracer) EnterDecorator(_ sdk.Context, chain, decorator string) {
+	r.calls = append(r.calls, "enter "+chain+" "+decorator)
+}
+
+func (r *recordingTracer) ExitDecorator(_ sdk.Context, chain, decorator string, err error) {
+	call := "exit " + chain + " " + decorator
+	if err != nil {
+		call += ": " + err.Error()
+	}
+	r.calls = append(r.calls, call)
+}
+
+func TestDecoratorTracer(t *testing.T) {
+	tracer := &recordingTracer{}
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// decorators are not traced without a tracer in the context
+	_, err := sdk.ChainAnteDecorators(testDecorator{}, testDecorator{})(ctx, nil, false)
+	require.NoError(t, err)
+	require.Empty(t, tracer.calls)
+
+	ctx = sdk.ContextWithDecoratorTracer(ctx, tracer)
+	_, err = sdk.ChainAnteDecorators(testDecorator{}, testDecorator{err: errors.New("fail")}, testDecorator{})(ctx, nil, false)
+	require.EqualError(t, err, "fail")
+	require.Equal(t, []string{
+		"enter ante types_test.testDecorator",
+		"enter ante types_test.testDecorator",
+		"exit ante types_test.testDecorator: fail",
+		"exit ante types_test.testDecorator: fail",
+	}, tracer.calls)
+
+	tracer.calls = nil
+	_, err = sdk.ChainPostDecorators(testDecorator{})(ctx, nil, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"enter post types_test.testDecorator",
+		"exit post types_test.testDecorator",
+	}, tracer.calls)
+}
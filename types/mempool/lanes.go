@@ -0,0 +1,197 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// Lane is a share of the block space reserved to the transactions it matches.
+// The transactions of a lane are held and ordered by its own mempool.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+	// Mempool holds the transactions of the lane, in the order in which they
+	// are included in a block.
+	Mempool Mempool
+	// Match returns whether a transaction belongs to the lane. A nil Match
+	// matches every transaction.
+	Match func(tx sdk.Tx) bool
+	// MaxBlockSpace is the maximum share of the bytes and of the gas of a block
+	// the transactions of the lane may use, in (0, 1].
+	MaxBlockSpace math.LegacyDec
+}
+
+// Matches returns whether tx belongs to the lane.
+func (l Lane) Matches(tx sdk.Tx) bool {
+	return l.Match == nil || l.Match(tx)
+}
+
+// MatchMsgTypeURLs returns a Lane.Match function matching the transactions
+// whose messages all have one of the given type URLs.
+func MatchMsgTypeURLs(typeURLs ...string) func(tx sdk.Tx) bool {
+	urls := make(map[string]bool, len(typeURLs))
+	for _, url := range typeURLs {
+		urls[url] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		for _, msg := range msgs {
+			if !urls[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+
+		return len(msgs) > 0
+	}
+}
+
+// MatchSigners returns a Lane.Match function matching the transactions whose
+// messages are all signed by the given addresses only.
+func MatchSigners(signers ...sdk.AccAddress) func(tx sdk.Tx) bool {
+	addrs := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		addrs[signer.String()] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		for _, msg := range msgs {
+			for _, signer := range msg.GetSigners() {
+				if !addrs[signer.String()] {
+					return false
+				}
+			}
+		}
+
+		return len(msgs) > 0
+	}
+}
+
+// LaneMempool is a mempool made of lanes. A transaction is inserted in the
+// mempool of the first lane matching it, and the transactions are selected
+// lane by lane, in the order of the lanes. The last lane usually matches every
+// transaction.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a mempool made of the given lanes.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("no lanes")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		switch {
+		case lane.Name == "":
+			return nil, errors.New("lane with no name")
+		case names[lane.Name]:
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		case lane.Mempool == nil:
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		case lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()):
+			return nil, fmt.Errorf("lane %s max block space must be in (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+		names[lane.Name] = true
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the first lane matching tx, or -1 if no lane
+// matches it.
+func (mp *LaneMempool) LaneIndex(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Matches(tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts tx in the mempool of the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return errors.New("no lane matches the transaction")
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes, lane by
+// lane.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return newLaneIterator(ctx, mp.lanes, 0, txs)
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	n := 0
+	for _, lane := range mp.lanes {
+		n += lane.Mempool.CountTx()
+	}
+
+	return n
+}
+
+// Remove removes tx from the mempool of the first lane matching it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// laneIterator iterates over the transactions of the lanes from lane i.
+type laneIterator struct {
+	ctx   context.Context
+	lanes []Lane
+	i     int
+	txs   [][]byte
+	it    Iterator
+}
+
+func newLaneIterator(ctx context.Context, lanes []Lane, i int, txs [][]byte) Iterator {
+	for ; i < len(lanes); i++ {
+		if it := lanes[i].Mempool.Select(ctx, txs); it != nil {
+			return &laneIterator{ctx: ctx, lanes: lanes, i: i, txs: txs, it: it}
+		}
+	}
+
+	return nil
+}
+
+// Next implements Iterator.
+func (it *laneIterator) Next() Iterator {
+	if next := it.it.Next(); next != nil {
+		return &laneIterator{ctx: it.ctx, lanes: it.lanes, i: it.i, txs: it.txs, it: next}
+	}
+
+	return newLaneIterator(it.ctx, it.lanes, it.i+1, it.txs)
+}
+
+// Tx implements Iterator.
+func (it *laneIterator) Tx() sdk.Tx {
+	return it.it.Tx()
+}
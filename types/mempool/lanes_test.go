@@ -0,0 +1,124 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// laneTx is a testTx with messages.
+type laneTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx laneTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	oracle, alice, bob := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	send := func(from sdk.AccAddress) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: from.String(), ToAddress: bob.String()}
+	}
+	vote := func(voter sdk.AccAddress) sdk.Msg {
+		return &govtypes.MsgVote{ProposalId: 1, Voter: voter.String()}
+	}
+	newTx := func(id int, from sdk.AccAddress, nonce uint64, msgs ...sdk.Msg) laneTx {
+		return laneTx{testTx: testTx{id: id, address: from, nonce: nonce}, msgs: msgs}
+	}
+
+	_, err := mempool.NewLaneMempool()
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool()})
+	require.ErrorContains(t, err, "max block space")
+	_, err = mempool.NewLaneMempool(mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyNewDec(2)})
+	require.ErrorContains(t, err, "max block space")
+	_, err = mempool.NewLaneMempool(
+		mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyOneDec()},
+		mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyOneDec()},
+	)
+	require.ErrorContains(t, err, "duplicate lane")
+
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "oracle",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			Match:         mempool.MatchSigners(oracle),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1),
+		},
+		mempool.Lane{
+			Name:          "gov",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			Match:         mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&govtypes.MsgVote{})),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, mp.Lanes(), 3)
+
+	txs := []laneTx{
+		newTx(0, alice, 0, send(alice)),
+		newTx(1, alice, 1, vote(alice)),
+		newTx(2, oracle, 0, send(oracle), vote(oracle)),
+		newTx(3, alice, 2, vote(alice), send(alice)),
+		newTx(4, oracle, 1, send(oracle), send(alice)),
+		newTx(5, bob, 0),
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	require.Equal(t, 0, mp.LaneIndex(txs[2]))
+	require.Equal(t, 1, mp.LaneIndex(txs[1]))
+	require.Equal(t, 2, mp.LaneIndex(txs[4]))
+	require.Equal(t, 2, mp.LaneIndex(txs[5]))
+
+	var ids []int
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(laneTx).id)
+	}
+	// the default lane mixes the transactions of several senders.
+	require.Equal(t, []int{2, 1}, ids[:2])
+	require.ElementsMatch(t, []int{0, 3, 4, 5}, ids[2:])
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, mp.Remove(txs[1]))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, len(txs)-2, mp.CountTx())
+	require.Zero(t, mp.Lanes()[0].Mempool.CountTx())
+
+	ids = nil
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(laneTx).id)
+	}
+	require.ElementsMatch(t, []int{0, 3, 4, 5}, ids)
+
+	mp, err = mempool.NewLaneMempool(mempool.Lane{
+		Name:          "gov",
+		Mempool:       mempool.NewSenderNonceMempool(),
+		Match:         mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&govtypes.MsgVote{})),
+		MaxBlockSpace: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+	require.Error(t, mp.Insert(ctx, txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Nil(t, mp.Select(ctx, nil))
+}
@@ -2,9 +2,11 @@ package mempool
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 type Mempool interface {
@@ -41,3 +43,26 @@ var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
 )
+
+// txNonce returns the nonce ordering a tx among the txs of its sender, and the
+// hash telling apart the unordered txs of the sender. An unordered tx does not
+// consume the sequence of its signers, which it may share with other unordered
+// txs, so it is ordered by its timeout height instead and identified by the
+// hash of its signature. The hash of the other txs is empty.
+func txNonce(tx sdk.Tx, sig txsigning.SignatureV2) (uint64, string, error) {
+	utx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !utx.GetUnordered() {
+		return sig.Sequence, "", nil
+	}
+	if sig.Data == nil {
+		return 0, "", errors.New("unordered tx must be signed")
+	}
+
+	bz, err := txsigning.SignatureDataToProto(sig.Data).Marshal()
+	if err != nil {
+		return 0, "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return utx.GetTimeoutHeight(), string(hash[:]), nil
+}
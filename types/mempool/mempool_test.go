@@ -80,6 +80,31 @@ func (tx testTx) String() string {
 	return fmt.Sprintf("tx a: %s, p: %d, n: %d", tx.address, tx.priority, tx.nonce)
 }
 
+// unorderedTx is an unordered testTx, with the signature sig.
+type unorderedTx struct {
+	testTx
+	timeout uint64
+	sig     []byte
+}
+
+func (tx unorderedTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	sig := txsigning.SignatureV2{
+		PubKey:   testPubKey{address: tx.address},
+		Sequence: tx.nonce,
+	}
+	if tx.sig != nil {
+		sig.Data = &txsigning.SingleSignatureData{Signature: tx.sig}
+	}
+
+	return []txsigning.SignatureV2{sig}, nil
+}
+
+func (tx unorderedTx) GetTimeoutHeight() uint64 { return tx.timeout }
+
+func (tx unorderedTx) GetUnordered() bool { return true }
+
+var _ sdk.TxWithUnordered = unorderedTx{}
+
 type sigErrTx struct {
 	getSigs func() ([]txsigning.SignatureV2, error)
 }
@@ -194,6 +219,53 @@ func (s *MempoolTestSuite) TestDefaultMempool() {
 	require.Equal(t, 1, s.mempool.CountTx())
 }
 
+func TestUnorderedTxs(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	// the txs share their sender and sequence, and the first ones their timeout.
+	txs := []unorderedTx{
+		{testTx: testTx{id: 0, address: accounts[0].Address}, timeout: 10, sig: []byte{0}},
+		{testTx: testTx{id: 1, address: accounts[0].Address}, timeout: 10, sig: []byte{1}},
+		{testTx: testTx{id: 2, address: accounts[0].Address}, timeout: 10, sig: []byte{2}},
+		{testTx: testTx{id: 3, address: accounts[0].Address}, timeout: 5, sig: []byte{3}},
+	}
+
+	mempools := map[string]func() mempool.Mempool{
+		"sender nonce":   func() mempool.Mempool { return mempool.NewSenderNonceMempool() },
+		"priority nonce": func() mempool.Mempool { return mempool.DefaultPriorityMempool() },
+	}
+
+	for name, newMempool := range mempools {
+		t.Run(name, func(t *testing.T) {
+			mp := newMempool()
+			for _, tx := range txs {
+				require.NoError(t, mp.Insert(ctx, tx))
+			}
+			require.Equal(t, len(txs), mp.CountTx())
+
+			// inserting the same tx again is a no-op
+			require.NoError(t, mp.Insert(ctx, txs[0]))
+			require.Equal(t, len(txs), mp.CountTx())
+
+			// the txs are ordered by timeout
+			sel := fetchTxs(mp.Select(ctx, nil), 100)
+			require.Len(t, sel, len(txs))
+			require.Equal(t, txs[3], sel[0])
+			require.ElementsMatch(t, []sdk.Tx{txs[0], txs[1], txs[2]}, sel[1:])
+
+			// an unsigned unordered tx cannot be told apart from the others
+			require.Error(t, mp.Insert(ctx, unorderedTx{testTx: txs[0].testTx, timeout: 10}))
+
+			for _, tx := range txs {
+				require.NoError(t, mp.Remove(tx))
+			}
+			require.Equal(t, 0, mp.CountTx())
+			require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+		})
+	}
+}
+
 type MempoolTestSuite struct {
 	suite.Suite
 	numTxs      int
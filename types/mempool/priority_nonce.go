@@ -84,8 +84,11 @@ type (
 
 	// txMeta stores transaction metadata used in indices
 	txMeta[C comparable] struct {
-		// nonce is the sender's sequence number
+		// nonce is the sender's sequence number, or the timeout height of an
+		// unordered transaction
 		nonce uint64
+		// hash tells apart the unordered transactions of the sender, see txNonce
+		hash string
 		// priority is the transaction's priority
 		priority C
 		// sender is the transaction's sender
@@ -119,7 +122,8 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 }
 
 // skiplistComparable is a comparator for txKeys that first compares priority,
-// then weight, then sender, then nonce, uniquely identifying a transaction.
+// then weight, then sender, then nonce, then hash, uniquely identifying a
+// transaction.
 //
 // Note, skiplistComparable is used as the comparator in the priority index.
 func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Comparable {
@@ -148,7 +152,12 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 			return res
 		}
 
-		return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		res = skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		if res != 0 {
+			return res
+		}
+
+		return skiplist.String.Compare(keyA.hash, keyB.hash)
 	})
 }
 
@@ -189,7 +198,9 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // transaction's first signature.
 //
 // Transactions are unique by sender and nonce. Inserting a duplicate tx is an
-// O(log n) no-op.
+// O(log n) no-op. Unordered transactions are ordered by timeout height among
+// the transactions of their sender, and are unique by the hash of their
+// signature.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
@@ -211,13 +222,21 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	nonce, hash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
+	key := txMeta[C]{nonce: nonce, hash: hash, priority: priority, sender: sender}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
-			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
+			res := skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
+			if res != 0 {
+				return res
+			}
+
+			return skiplist.String.Compare(b.(txMeta[C]).hash, a.(txMeta[C]).hash)
 		}))
 
 		// initialize sender index if not found
@@ -231,7 +250,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
+	sk := txMeta[C]{nonce: nonce, hash: hash, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
@@ -245,6 +264,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
+			hash:     hash,
 			sender:   sender,
 			priority: oldScore.priority,
 			weight:   oldScore.weight,
@@ -317,7 +337,7 @@ func (i *PriorityNonceIterator[C]) Next() Iterator {
 	} else if i.mempool.cfg.TxPriority.Compare(key.priority, i.nextPriority) == 0 {
 		// Weight is incorporated into the priority index key only (not sender index)
 		// so we must fetch it here from the scores map.
-		weight := i.mempool.scores[txMeta[C]{nonce: key.nonce, sender: key.sender}].weight
+		weight := i.mempool.scores[txMeta[C]{nonce: key.nonce, hash: key.hash, sender: key.sender}].weight
 		if i.mempool.cfg.TxPriority.Compare(weight, i.priorityNode.Next().Key().(txMeta[C]).weight) < 0 {
 			return i.iteratePriority()
 		}
@@ -378,9 +398,9 @@ func (mp *PriorityNonceMempool[C]) reorderPriorityTies() {
 
 	for _, k := range reordering {
 		mp.priorityIndex.Remove(k.deleteKey)
-		delete(mp.scores, txMeta[C]{nonce: k.deleteKey.nonce, sender: k.deleteKey.sender})
+		delete(mp.scores, txMeta[C]{nonce: k.deleteKey.nonce, hash: k.deleteKey.hash, sender: k.deleteKey.sender})
 		mp.priorityIndex.Set(k.insertKey, k.tx)
-		mp.scores[txMeta[C]{nonce: k.insertKey.nonce, sender: k.insertKey.sender}] = k.insertKey
+		mp.scores[txMeta[C]{nonce: k.insertKey.nonce, hash: k.insertKey.hash, sender: k.insertKey.sender}] = k.insertKey
 	}
 }
 
@@ -425,14 +445,17 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, hash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
 
-	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
+	scoreKey := txMeta[C]{nonce: nonce, hash: hash, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
 		return ErrTxNotFound
 	}
-	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
+	tk := txMeta[C]{nonce: nonce, hash: hash, priority: score.priority, sender: sender, weight: score.weight}
 
 	senderTxs, ok := mp.senderIndices[sender]
 	if !ok {
@@ -42,8 +42,22 @@ type SenderNonceOptions func(*SenderNonceMempool)
 type txKey struct {
 	address string
 	nonce   uint64
+	hash    string
 }
 
+// txKeyOrder orders the txs of a sender by nonce, then by hash.
+var txKeyOrder = skiplist.GreaterThanFunc(func(a, b any) int {
+	keyA := a.(txKey)
+	keyB := b.(txKey)
+
+	res := skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+	if res != 0 {
+		return res
+	}
+
+	return skiplist.String.Compare(keyA.hash, keyB.hash)
+})
+
 // NewSenderNonceMempool creates a new mempool that prioritizes transactions by
 // nonce, the lowest first, picking a random sender on each iteration.
 func NewSenderNonceMempool(opts ...SenderNonceOptions) *SenderNonceMempool {
@@ -114,7 +128,8 @@ func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
 }
 
 // Insert adds a tx to the mempool. It returns an error if the tx does not have
-// at least one signer. Note, priority is ignored.
+// at least one signer. Note, priority is ignored. Unordered txs are ordered by
+// timeout height among the txs of their sender.
 func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	if snm.maxTx > 0 && snm.CountTx() >= snm.maxTx {
 		return ErrMempoolTxMaxCapacity
@@ -133,17 +148,19 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, hash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
-		senderTxs = skiplist.New(skiplist.Uint64)
+		senderTxs = skiplist.New(txKeyOrder)
 		snm.senders[sender] = senderTxs
 	}
 
-	senderTxs.Set(nonce, tx)
-
-	key := txKey{nonce: nonce, address: sender}
+	key := txKey{nonce: nonce, address: sender, hash: hash}
+	senderTxs.Set(key, tx)
 	snm.existingTx[key] = true
 
 	return nil
@@ -200,14 +217,18 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, hash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
 		return ErrTxNotFound
 	}
 
-	res := senderTxs.Remove(nonce)
+	key := txKey{nonce: nonce, address: sender, hash: hash}
+	res := senderTxs.Remove(key)
 	if res == nil {
 		return ErrTxNotFound
 	}
@@ -216,7 +237,6 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 		delete(snm.senders, sender)
 	}
 
-	key := txKey{nonce: nonce, address: sender}
 	delete(snm.existingTx, key)
 
 	return nil
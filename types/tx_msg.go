@@ -72,6 +72,15 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// executed regardless of the sequence of its signers. Such a transaction
+	// must set a height timeout.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BeginBlocker removes the unordered txs which timed out before the block, as
+// they cannot be replayed anymore.
+func BeginBlocker(ctx sdk.Context, ak keeper.AccountKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	ak.RemoveExpiredUnorderedTxs(ctx, uint64(ctx.BlockHeight()))
+}
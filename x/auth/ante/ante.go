@@ -21,6 +21,12 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// UnorderedTxKeeper records the unordered txs for replay protection. If
+	// nil, unordered txs are rejected.
+	UnorderedTxKeeper UnorderedTxKeeper
+	// RateLimitKeeper enforces the rate limits of the msgs. If nil, the msgs
+	// are not rate limited.
+	RateLimitKeeper RateLimitKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -44,6 +50,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(DefaultMaxUnorderedTxTimeout, options.UnorderedTxKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
@@ -52,6 +59,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewRateLimitDecorator(options.RateLimitKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
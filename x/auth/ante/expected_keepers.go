@@ -18,3 +18,16 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// UnorderedTxKeeper defines the expected keeper recording the executed unordered
+// txs until their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, hash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, hash []byte, timeout uint64)
+}
+
+// RateLimitKeeper defines the expected keeper counting the msgs against the
+// rate limits.
+type RateLimitKeeper interface {
+	ConsumeRateLimits(ctx sdk.Context, msgs []sdk.Msg) error
+}
@@ -0,0 +1,36 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimitDecorator enforces the rate limits of the messages of a tx, per
+// signer, per message type or global, with a RateLimitKeeper such as the
+// x/ratelimit keeper. It is a no-op with a nil keeper.
+//
+// The limits are enforced in ReCheckTx too, the txs in the mempool being
+// counted again on the CheckTx state reset at Commit, but not in simulations.
+// The decorator should be chained after the signature verification, so that
+// the messages of a tx count towards the limits of its signers only when they
+// signed it.
+type RateLimitDecorator struct {
+	keeper RateLimitKeeper
+}
+
+func NewRateLimitDecorator(keeper RateLimitKeeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		keeper: keeper,
+	}
+}
+
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if rld.keeper == nil || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := rld.keeper.ConsumeRateLimits(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,56 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// rateLimitKeeper allows maxMsgs msgs in total.
+type rateLimitKeeper struct {
+	maxMsgs int
+	msgs    *int
+}
+
+func (k rateLimitKeeper) ConsumeRateLimits(_ sdk.Context, msgs []sdk.Msg) error {
+	if *k.msgs+len(msgs) > k.maxMsgs {
+		return errors.New("rate limited")
+	}
+	*k.msgs += len(msgs)
+	return nil
+}
+
+func TestRateLimitDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr), testdata.NewTestMsg(addr)))
+	tx := suite.txBuilder.GetTx()
+
+	var msgs int
+	antehandler := sdk.ChainAnteDecorators(ante.NewRateLimitDecorator(rateLimitKeeper{maxMsgs: 3, msgs: &msgs}))
+
+	_, err := antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, msgs)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	require.EqualError(t, err, "rate limited")
+
+	// the limits are enforced in ReCheckTx, not in simulations
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), tx, false)
+	require.EqualError(t, err, "rate limited")
+	_, err = antehandler(suite.ctx, tx, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, msgs)
+
+	// no limits without a keeper
+	_, err = sdk.ChainAnteDecorators(ante.NewRateLimitDecorator(nil))(suite.ctx, tx, false)
+	require.NoError(t, err)
+}
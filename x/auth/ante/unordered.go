@@ -0,0 +1,135 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultMaxUnorderedTxTimeout is the default maximum number of blocks between
+// the current block height and the timeout height of an unordered tx.
+const DefaultMaxUnorderedTxTimeout uint64 = 1024
+
+// IsUnorderedTx returns whether tx is an unordered tx, executed regardless of
+// the sequences of its signers.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// UnorderedTxDecorator protects the unordered txs from replay. An unordered tx
+// must set a timeout height at most maxTimeout blocks after the current block
+// height, and its hash is recorded until its timeout height, after which it
+// cannot be executed anymore. A tx whose hash is recorded is rejected.
+//
+// SigVerificationDecorator and IncrementSequenceDecorator skip the sequence
+// checks of unordered txs, so an AnteHandler chaining them must also chain the
+// UnorderedTxDecorator. With a nil keeper, unordered txs are rejected.
+type UnorderedTxDecorator struct {
+	maxTimeout uint64
+	keeper     UnorderedTxKeeper
+}
+
+func NewUnorderedTxDecorator(maxTimeout uint64, keeper UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTimeout: maxTimeout,
+		keeper:     keeper,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if utd.keeper == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	unorderedTx := tx.(sdk.TxWithUnordered)
+	height := uint64(ctx.BlockHeight())
+	timeout := unorderedTx.GetTimeoutHeight()
+	switch {
+	case timeout == 0:
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have a timeout height")
+
+	case timeout < height:
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", height, timeout)
+
+	case timeout-height > utd.maxTimeout:
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered transaction timeout height %d is more than %d blocks after the block height %d", timeout, utd.maxTimeout, height,
+		)
+	}
+
+	txHash, err := UnorderedTxHash(unorderedTx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if utd.keeper.ContainsUnorderedTx(ctx, txHash) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction has already been executed")
+	}
+	utd.keeper.AddUnorderedTx(ctx, txHash, timeout)
+
+	return next(ctx, tx, simulate)
+}
+
+// UnorderedTxHash returns the hash identifying an unordered tx for replay
+// protection. It covers what every sign mode signs, i.e. the messages, memo,
+// timeout height, fee and tip of the tx, in a canonical encoding. Unlike the
+// hash of the tx bytes, it does not change when the tx is encoded or signed
+// differently.
+func UnorderedTxHash(unorderedTx sdk.TxWithUnordered) ([]byte, error) {
+	h := sha256.New()
+
+	msgs := unorderedTx.GetMsgs()
+	writeUint64(h, uint64(len(msgs)))
+	for _, msg := range msgs {
+		bz, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		writeBytes(h, []byte(sdk.MsgTypeURL(msg)))
+		writeBytes(h, bz)
+	}
+
+	if memoTx, ok := unorderedTx.(sdk.TxWithMemo); ok {
+		writeBytes(h, []byte(memoTx.GetMemo()))
+	}
+	writeUint64(h, unorderedTx.GetTimeoutHeight())
+
+	if feeTx, ok := unorderedTx.(sdk.FeeTx); ok {
+		writeBytes(h, []byte(feeTx.GetFee().String()))
+		writeUint64(h, feeTx.GetGas())
+		writeBytes(h, feeTx.FeePayer())
+		writeBytes(h, feeTx.FeeGranter())
+	}
+
+	if tipTx, ok := unorderedTx.(tx.TipTx); ok && tipTx.GetTip() != nil {
+		writeBytes(h, []byte(tipTx.GetTip().Amount.String()))
+		writeBytes(h, []byte(tipTx.GetTip().Tipper))
+	}
+
+	return h.Sum(nil), nil
+}
+
+func writeUint64(h hash.Hash, v uint64) {
+	h.Write(binary.BigEndian.AppendUint64(nil, v))
+}
+
+// writeBytes writes bz prefixed with its length, so that the concatenation of
+// the fields of a tx is unambiguous.
+func writeBytes(h hash.Hash, bz []byte) {
+	writeUint64(h, uint64(len(bz)))
+	h.Write(bz)
+}
@@ -0,0 +1,144 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+
+	newTx := func(unordered bool, timeout uint64, memo string) authsigning.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetTimeoutHeight(timeout)
+		require.NoError(t, suite.txBuilder.SetUnordered(unordered))
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	ctx := suite.ctx.WithBlockHeight(100)
+	antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(10, suite.accountKeeper))
+
+	testCases := []struct {
+		name        string
+		tx          sdk.Tx
+		expectedErr error
+	}{
+		{"ordered tx", newTx(false, 0, ""), nil},
+		{"no timeout", newTx(true, 0, ""), sdkerrors.ErrInvalidRequest},
+		{"timed out", newTx(true, 99, ""), sdkerrors.ErrTxTimeoutHeight},
+		{"timeout too far", newTx(true, 111, ""), sdkerrors.ErrInvalidRequest},
+		{"valid", newTx(true, 110, ""), nil},
+		{"replayed", newTx(true, 110, ""), sdkerrors.ErrInvalidRequest},
+		{"different memo", newTx(true, 110, "memo"), nil},
+		{"same timeout height as block height", newTx(true, 100, ""), nil},
+	}
+
+	for _, tc := range testCases {
+		_, err := antehandler(ctx, tc.tx, false)
+		require.ErrorIs(t, err, tc.expectedErr, tc.name)
+	}
+
+	// without a keeper, unordered txs are not supported
+	antehandler = sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(10, nil))
+	_, err := antehandler(ctx, newTx(false, 0, ""), false)
+	require.NoError(t, err)
+	_, err = antehandler(ctx, newTx(true, 110, "no keeper"), false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
+
+func TestUnorderedTxHash(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	priv1, _, addr := testdata.KeyTestPubAddr()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+
+	newTx := func(priv cryptotypes.PrivKey, seq uint64, memo string) sdk.TxWithUnordered {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetTimeoutHeight(10)
+		require.NoError(t, suite.txBuilder.SetUnordered(true))
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx.(sdk.TxWithUnordered)
+	}
+
+	hash, err := ante.UnorderedTxHash(newTx(priv1, 0, "memo"))
+	require.NoError(t, err)
+
+	// the hash does not depend on the signatures
+	otherHash, err := ante.UnorderedTxHash(newTx(priv2, 5, "memo"))
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+
+	otherHash, err = ante.UnorderedTxHash(newTx(priv1, 0, "other memo"))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+}
+
+func TestAnteHandlerUnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:     suite.accountKeeper,
+			BankKeeper:        suite.bankKeeper,
+			FeegrantKeeper:    suite.feeGrantKeeper,
+			SignModeHandler:   suite.encCfg.TxConfig.SignModeHandler(),
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: suite.accountKeeper,
+		},
+	)
+	require.NoError(t, err)
+
+	accs := suite.CreateTestAccounts(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	newTx := func(seq uint64, memo string) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetTimeoutHeight(10)
+		require.NoError(t, suite.txBuilder.SetUnordered(true))
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// unordered txs are executed regardless of the sequence of their signers,
+	// which is not incremented.
+	for i, seq := range []uint64{5, 0, 5} {
+		_, err := anteHandler(suite.ctx, newTx(seq, string(rune('a'+i))), false)
+		require.NoError(t, err)
+		require.Zero(t, suite.accountKeeper.GetAccount(suite.ctx, accs[0].acc.GetAddress()).GetSequence())
+	}
+
+	// but they cannot be replayed
+	_, err = anteHandler(suite.ctx, newTx(0, "a"), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns whether an unordered tx with the given hash was
+// executed and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, hash []byte) bool {
+	store := ctx.KVStore(ak.storeKey)
+	return store.Has(types.UnorderedTxHashKey(hash))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, hash []byte, timeout uint64) {
+	store := ctx.KVStore(ak.storeKey)
+	store.Set(types.UnorderedTxHashKey(hash), sdk.Uint64ToBigEndian(timeout))
+	store.Set(types.UnorderedTxTimeoutKey(timeout, hash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs whose
+// timeout height is lower than height, as they cannot be executed anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(ak.storeKey)
+
+	var keys [][]byte
+	iterator := store.Iterator(types.UnorderedTxTimeoutPrefix, types.UnorderedTxTimeoutKey(height, nil))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		hash := key[len(types.UnorderedTxTimeoutPrefix)+8:]
+		store.Delete(types.UnorderedTxHashKey(hash))
+		store.Delete(key)
+	}
+}
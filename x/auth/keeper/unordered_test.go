@@ -0,0 +1,21 @@
+package keeper_test
+
+func (suite *KeeperTestSuite) TestUnorderedTxs() {
+	ctx := suite.ctx
+
+	hash1, hash2, hash3 := []byte("hash1"), []byte("hash2"), []byte("hash3")
+	suite.accountKeeper.AddUnorderedTx(ctx, hash1, 10)
+	suite.accountKeeper.AddUnorderedTx(ctx, hash2, 11)
+	suite.accountKeeper.AddUnorderedTx(ctx, hash3, 12)
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, hash1))
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, []byte("unknown")))
+
+	// a tx can still be executed at its timeout height
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx, 10)
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, hash1))
+
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx, 12)
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, hash1))
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, hash2))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, hash3))
+}
@@ -37,6 +37,7 @@ type StdSignDoc struct {
 	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
 	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	Unordered     bool              `json:"unordered,omitempty" yaml:"unordered"`
 	ChainID       string            `json:"chain_id" yaml:"chain_id"`
 	Memo          string            `json:"memo" yaml:"memo"`
 	Fee           json.RawMessage   `json:"fee" yaml:"fee"`
@@ -46,6 +47,17 @@ type StdSignDoc struct {
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string, tip *tx.Tip) []byte {
+	return stdSignBytes(chainID, accnum, sequence, timeout, false, fee, msgs, memo, tip)
+}
+
+// UnorderedStdSignBytes returns the bytes to sign for an unordered transaction.
+// They only differ from the StdSignBytes of the same transaction by the
+// unordered field, which is omitted for the other transactions.
+func UnorderedStdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string, tip *tx.Tip) []byte {
+	return stdSignBytes(chainID, accnum, sequence, timeout, true, fee, msgs, memo, tip)
+}
+
+func stdSignBytes(chainID string, accnum, sequence, timeout uint64, unordered bool, fee StdFee, msgs []sdk.Msg, memo string, tip *tx.Tip) []byte {
 	msgsBytes := make([]json.RawMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		legacyMsg, ok := msg.(LegacyMsg)
@@ -73,6 +85,7 @@ func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
 		TimeoutHeight: timeout,
+		Unordered:     unordered,
 		Tip:           stdTip,
 	})
 	if err != nil {
@@ -59,6 +59,15 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered returns an error for an unordered tx, which StdTx cannot encode.
+func (s *StdTxBuilder) SetUnordered(unordered bool) error {
+	if unordered {
+		return sdkerrors.ErrLogic.Wrap("cannot use unordered transactions with StdTxBuilder")
+	}
+
+	return nil
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
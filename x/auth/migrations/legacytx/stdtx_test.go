@@ -13,6 +13,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -188,3 +189,10 @@ func TestGetSignaturesV2(t *testing.T) {
 		Signature: sig.GetSignature(),
 	})
 }
+
+func TestStdTxBuilderSetUnordered(t *testing.T) {
+	builder := &StdTxBuilder{}
+
+	require.NoError(t, builder.SetUnordered(false))
+	require.ErrorIs(t, builder.SetUnordered(true), sdkerrors.ErrLogic)
+}
@@ -1,15 +1,40 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// RefundUnusedFees enables the refund of the fee paying for unused gas,
+	// see NewRefundDecorator.
+	RefundUnusedFees bool
+	// RefundMinChargeFraction is the minimum fraction of the fee charged when
+	// refunding unused fees, zero if nil.
+	RefundMinChargeFraction math.LegacyDec
+	BankKeeper              BankKeeper
+	FeegrantKeeper          FeegrantKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, empty unless RefundUnusedFees is
+// set.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.RefundUnusedFees {
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for post builder")
+		}
+		if !options.RefundMinChargeFraction.IsNil() && (options.RefundMinChargeFraction.IsNegative() || options.RefundMinChargeFraction.GT(math.LegacyOneDec())) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "refund min charge fraction must be in [0, 1], got %s", options.RefundMinChargeFraction)
+		}
+
+		postDecorators = append(postDecorators, NewRefundDecorator(options.BankKeeper, options.FeegrantKeeper, options.RefundMinChargeFraction))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
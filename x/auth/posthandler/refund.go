@@ -0,0 +1,119 @@
+package posthandler
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// refundDecorator refunds the share of the fee paying for the gas a tx did not
+// use, the fee being deducted in full by ante.DeductFeeDecorator.
+type refundDecorator struct {
+	bankKeeper        BankKeeper
+	feegrantKeeper    FeegrantKeeper
+	minChargeFraction math.LegacyDec
+}
+
+// NewRefundDecorator returns a decorator refunding the unused share of the fee
+// deducted by ante.DeductFeeDecorator, that is
+//
+//	(gasLimit - gasUsed) / gasLimit * fee
+//
+// rounded down, to the account the fee was deducted from. At least
+// minChargeFraction of the fee, in [0, 1], is always charged. When the fee was
+// paid by a fee grant, the refund is also given back to the allowance of the
+// grant, if it still exists. The gas used is read when the decorator runs, so
+// it should be the first post decorator.
+//
+// Nothing is refunded for a tx whose fee was not deducted by
+// ante.DeductFeeDecorator, or whose msgs failed, since the post handler only
+// runs on success.
+func NewRefundDecorator(bk BankKeeper, fk FeegrantKeeper, minChargeFraction math.LegacyDec) sdk.PostDecorator {
+	if minChargeFraction.IsNil() {
+		minChargeFraction = math.LegacyZeroDec()
+	}
+	if minChargeFraction.IsNegative() || minChargeFraction.GT(math.LegacyOneDec()) {
+		panic(fmt.Errorf("min charge fraction must be in [0, 1], got %s", minChargeFraction))
+	}
+
+	return refundDecorator{
+		bankKeeper:        bk,
+		feegrantKeeper:    fk,
+		minChargeFraction: minChargeFraction,
+	}
+}
+
+func (d refundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success {
+		if err := d.refund(ctx, tx); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+func (d refundDecorator) refund(ctx sdk.Context, tx sdk.Tx) error {
+	deducted, ok := ante.DeductedFeeFromContext(ctx)
+	if !ok || deducted.Fee.IsZero() {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return nil
+	}
+
+	refund := RefundedFee(deducted.Fee, ctx.GasMeter().GasConsumed(), feeTx.GetGas(), d.minChargeFraction)
+	if refund.IsZero() {
+		return nil
+	}
+
+	refundTo := deducted.FeePayer
+	if deducted.FeeGranter != nil {
+		refundTo = deducted.FeeGranter
+	}
+
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return err
+	}
+
+	if deducted.FeeGranter != nil {
+		if d.feegrantKeeper == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		}
+
+		return d.feegrantKeeper.RefundGrantedFees(ctx, deducted.FeeGranter, deducted.FeePayer, refund)
+	}
+
+	return nil
+}
+
+// RefundedFee returns the share of fee paying for the gas not used out of
+// gasLimit, rounded down, at least minChargeFraction of fee being charged.
+func RefundedFee(fee sdk.Coins, gasUsed, gasLimit uint64, minChargeFraction math.LegacyDec) sdk.Coins {
+	if gasLimit == 0 {
+		return sdk.NewCoins()
+	}
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+
+	charged := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).QuoInt(math.NewIntFromUint64(gasLimit))
+	if charged.LT(minChargeFraction) {
+		charged = minChargeFraction
+	}
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		chargedAmount := charged.MulInt(coin.Amount).Ceil().TruncateInt()
+		refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(chargedAmount)))
+	}
+
+	return refund
+}
@@ -0,0 +1,227 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type feeTx struct {
+	gas        uint64
+	fee        sdk.Coins
+	feePayer   sdk.AccAddress
+	feeGranter sdk.AccAddress
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx feeTx) ValidateBasic() error       { return nil }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return tx.feePayer }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return tx.feeGranter }
+
+type accountKeeper struct{}
+
+func (accountKeeper) GetParams(sdk.Context) authtypes.Params { return authtypes.DefaultParams() }
+func (accountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+func (accountKeeper) SetAccount(sdk.Context, sdk.AccountI) {}
+func (accountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// bankKeeper records the balances of the accounts.
+type bankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bankKeeper) IsSendEnabledCoins(sdk.Context, ...sdk.Coin) error { return nil }
+func (bankKeeper) SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (k bankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, addr sdk.AccAddress, module string, amt sdk.Coins) error {
+	k.balances[addr.String()] = k.balances[addr.String()].Sub(amt...)
+	k.balances[module] = k.balances[module].Add(amt...)
+	return nil
+}
+
+func (k bankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, module string, addr sdk.AccAddress, amt sdk.Coins) error {
+	k.balances[module] = k.balances[module].Sub(amt...)
+	k.balances[addr.String()] = k.balances[addr.String()].Add(amt...)
+	return nil
+}
+
+// feegrantKeeper records the spent allowance of the granters.
+type feegrantKeeper struct {
+	spent map[string]sdk.Coins
+}
+
+func (k feegrantKeeper) UseGrantedFees(_ sdk.Context, granter, _ sdk.AccAddress, fee sdk.Coins, _ []sdk.Msg) error {
+	k.spent[granter.String()] = k.spent[granter.String()].Add(fee...)
+	return nil
+}
+
+func (k feegrantKeeper) RefundGrantedFees(_ sdk.Context, granter, _ sdk.AccAddress, refund sdk.Coins) error {
+	k.spent[granter.String()] = k.spent[granter.String()].Sub(refund...)
+	return nil
+}
+
+func TestRefundedFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("eth", 3))
+
+	cases := map[string]struct {
+		gasUsed, gasLimit uint64
+		minCharge         math.LegacyDec
+		refund            sdk.Coins
+	}{
+		"all gas used":    {100, 100, math.LegacyZeroDec(), sdk.NewCoins()},
+		"gas above limit": {150, 100, math.LegacyZeroDec(), sdk.NewCoins()},
+		"no gas used":     {0, 100, math.LegacyZeroDec(), fee},
+		"no gas limit":    {0, 0, math.LegacyZeroDec(), sdk.NewCoins()},
+		"half gas used rounds down": {
+			50, 100, math.LegacyZeroDec(),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("eth", 1)),
+		},
+		"min charge": {
+			10, 100, math.LegacyNewDecWithPrec(4, 1),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("eth", 1)),
+		},
+		"min charge below gas used": {
+			70, 100, math.LegacyNewDecWithPrec(4, 1),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 300)),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.refund, posthandler.RefundedFee(fee, tc.gasUsed, tc.gasLimit, tc.minCharge))
+		})
+	}
+}
+
+func TestRefundDecorator(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	granter := sdk.AccAddress("granter")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	feeCollector := authtypes.FeeCollectorName
+
+	require.Panics(t, func() {
+		posthandler.NewRefundDecorator(bankKeeper{}, feegrantKeeper{}, math.LegacyNewDec(2))
+	})
+
+	cases := map[string]struct {
+		tx        feeTx
+		gasUsed   uint64
+		minCharge math.LegacyDec
+		success   bool
+		deduct    bool
+		balances  map[string]sdk.Coins
+		spent     map[string]sdk.Coins
+	}{
+		"refund to fee payer": {
+			tx:        feeTx{gas: 100, fee: fee, feePayer: payer},
+			gasUsed:   40,
+			minCharge: math.LegacyZeroDec(),
+			success:   true,
+			deduct:    true,
+			balances: map[string]sdk.Coins{
+				payer.String():   sdk.NewCoins(sdk.NewInt64Coin("atom", 600)),
+				granter.String(): fee,
+				feeCollector:     sdk.NewCoins(sdk.NewInt64Coin("atom", 400)),
+			},
+		},
+		"refund to fee granter": {
+			tx:        feeTx{gas: 100, fee: fee, feePayer: payer, feeGranter: granter},
+			gasUsed:   40,
+			minCharge: math.LegacyNewDecWithPrec(5, 1),
+			success:   true,
+			deduct:    true,
+			balances: map[string]sdk.Coins{
+				payer.String():   fee,
+				granter.String(): sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+				feeCollector:     sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+			},
+			spent: map[string]sdk.Coins{
+				granter.String(): sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+			},
+		},
+		"fee payer is fee granter": {
+			tx:        feeTx{gas: 100, fee: fee, feePayer: payer, feeGranter: payer},
+			gasUsed:   40,
+			minCharge: math.LegacyZeroDec(),
+			success:   true,
+			deduct:    true,
+			balances: map[string]sdk.Coins{
+				payer.String():   sdk.NewCoins(sdk.NewInt64Coin("atom", 600)),
+				granter.String(): fee,
+				feeCollector:     sdk.NewCoins(sdk.NewInt64Coin("atom", 400)),
+			},
+		},
+		"failed tx": {
+			tx:        feeTx{gas: 100, fee: fee, feePayer: payer},
+			gasUsed:   40,
+			minCharge: math.LegacyZeroDec(),
+			success:   false,
+			deduct:    true,
+			balances: map[string]sdk.Coins{
+				payer.String():   sdk.NewCoins(),
+				granter.String(): fee,
+				feeCollector:     fee,
+			},
+		},
+		"fee not deducted": {
+			tx:        feeTx{gas: 100, fee: fee, feePayer: payer},
+			gasUsed:   40,
+			minCharge: math.LegacyZeroDec(),
+			success:   true,
+			deduct:    false,
+			balances: map[string]sdk.Coins{
+				payer.String():   fee,
+				granter.String(): fee,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bk := bankKeeper{balances: map[string]sdk.Coins{payer.String(): fee, granter.String(): fee}}
+			fk := feegrantKeeper{spent: map[string]sdk.Coins{}}
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).
+				WithGasMeter(storetypes.NewGasMeter(tc.tx.gas))
+
+			if tc.deduct {
+				dfd := ante.NewDeductFeeDecorator(accountKeeper{}, bk, fk, nil)
+				var err error
+				ctx, err = sdk.ChainAnteDecorators(dfd)(ctx, tc.tx, false)
+				require.NoError(t, err)
+			}
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "msgs")
+
+			postHandler := sdk.ChainPostDecorators(posthandler.NewRefundDecorator(bk, fk, tc.minCharge))
+			_, err := postHandler(ctx, tc.tx, false, tc.success)
+			require.NoError(t, err)
+
+			require.Equal(t, len(tc.balances), len(bk.balances))
+			for addr, balance := range tc.balances {
+				require.Equal(t, balance.String(), bk.balances[addr].String(), addr)
+			}
+			for granter, spent := range fk.spent {
+				require.Equal(t, tc.spent[granter].String(), spent.String(), granter)
+			}
+		})
+	}
+}
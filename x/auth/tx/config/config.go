@@ -6,6 +6,7 @@ import (
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -37,6 +38,12 @@ type TxInputs struct {
 	// TxBankKeeper is the expected bank keeper to be passed to Textual
 	TxBankKeeper   BankKeeper
 	FeeGrantKeeper ante.FeegrantKeeper `optional:"true"`
+	// TxFeeChecker replaces the validator minimum gas prices check of the
+	// AnteHandlers, e.g. with the x/feemarket base fee check
+	TxFeeChecker ante.TxFeeChecker `optional:"true"`
+	// RateLimitKeeper enforces the rate limits of the messages in the
+	// AnteHandlers, e.g. the x/ratelimit keeper
+	RateLimitKeeper ante.RateLimitKeeper `optional:"true"`
 
 	CustomSignModeHandlers func() []signing.SignModeHandler `optional:"true"`
 }
@@ -81,14 +88,13 @@ func ProvideModule(in TxInputs) TxOutputs {
 			// meaning that both `runMsgs` and `postHandler` state will be committed if
 			// both are successful, and both will be reverted if any of the two fails.
 			//
-			// The SDK exposes a default empty postHandlers chain.
+			// The SDK exposes a default postHandlers chain, empty unless
+			// refund_unused_fees is set.
 			//
 			// Please note that changing any of the anteHandler or postHandler chain is
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
-			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
-			)
+			postHandler, err := newPostHandler(in)
 			if err != nil {
 				panic(err)
 			}
@@ -108,13 +114,19 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the x/auth keeper records the unordered txs
+	unorderedTxKeeper, _ := in.AccountKeeper.(ante.UnorderedTxKeeper)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: unorderedTxKeeper,
+			TxFeeChecker:      in.TxFeeChecker,
+			RateLimitKeeper:   in.RateLimitKeeper,
 		},
 	)
 	if err != nil {
@@ -123,3 +135,32 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 
 	return anteHandler, nil
 }
+
+func newPostHandler(in TxInputs) (sdk.PostHandler, error) {
+	options := posthandler.HandlerOptions{
+		RefundUnusedFees: in.Config.RefundUnusedFees,
+	}
+
+	if in.Config.RefundMinChargeFraction != "" {
+		fraction, err := math.LegacyNewDecFromStr(in.Config.RefundMinChargeFraction)
+		if err != nil {
+			return nil, fmt.Errorf("invalid refund min charge fraction: %w", err)
+		}
+		options.RefundMinChargeFraction = fraction
+	}
+
+	// the bank and x/feegrant keepers of the AnteHandlers refund the fees
+	if bankKeeper, ok := in.BankKeeper.(posthandler.BankKeeper); ok {
+		options.BankKeeper = bankKeeper
+	}
+	if feegrantKeeper, ok := in.FeeGrantKeeper.(posthandler.FeegrantKeeper); ok {
+		options.FeegrantKeeper = feegrantKeeper
+	}
+
+	postHandler, err := posthandler.NewPostHandler(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post handler: %w", err)
+	}
+
+	return postHandler, nil
+}
@@ -47,6 +47,7 @@ func TestUnknownFields(t *testing.T) {
 	tests := []struct {
 		name           string
 		body           *testdata.TestUpdatedTxBody
+		bodyNewFields  []byte
 		authInfo       *testdata.TestUpdatedAuthInfo
 		shouldErr      bool
 		shouldAminoErr string
@@ -70,13 +71,23 @@ func TestUnknownFields(t *testing.T) {
 			shouldAminoErr: fmt.Sprintf("%s: %s", aminoNonCriticalFieldsError, sdkerrors.ErrInvalidRequest.Error()),
 		},
 		{
-			name: "critical fields in TxBody should error on decode",
+			// field 4 of TxBody is now the unordered field.
+			name: "unordered field in TxBody should not error on decode",
 			body: &testdata.TestUpdatedTxBody{
 				Memo:         "foo",
-				SomeNewField: 10,
+				SomeNewField: 1,
 			},
 			authInfo:  &testdata.TestUpdatedAuthInfo{},
-			shouldErr: true,
+			shouldErr: false,
+		},
+		{
+			name: "critical fields in TxBody should error on decode",
+			body: &testdata.TestUpdatedTxBody{
+				Memo: "foo",
+			},
+			bodyNewFields: protowire.AppendVarint(protowire.AppendTag(nil, 5, protowire.VarintType), 10),
+			authInfo:      &testdata.TestUpdatedAuthInfo{},
+			shouldErr:     true,
 		},
 		{
 			name: "critical fields in AuthInfo should error on decode",
@@ -105,6 +116,7 @@ func TestUnknownFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			bodyBz, err := tt.body.Marshal()
 			require.NoError(t, err)
+			bodyBz = append(bodyBz, tt.bodyNewFields...)
 
 			authInfoBz, err := tt.authInfo.Marshal()
 			require.NoError(t, err)
@@ -56,10 +56,15 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 	tip := protoTx.GetTip()
 	isTipper := tip != nil && tip.Tipper == addr
 
+	signBytes := legacytx.StdSignBytes
+	if body.Unordered {
+		signBytes = legacytx.UnorderedStdSignBytes
+	}
+
 	// We set a convention that if the tipper signs with LEGACY_AMINO_JSON, then
 	// they sign over empty fees and 0 gas.
 	if isTipper {
-		return legacytx.StdSignBytes(
+		return signBytes(
 			data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
 			// The tipper signs over 0 fee and 0 gas, no feepayer, no feegranter by convention.
 			legacytx.StdFee{},
@@ -67,7 +72,7 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		), nil
 	}
 
-	return legacytx.StdSignBytes(
+	return signBytes(
 		data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
 		legacytx.StdFee{
 			Amount:  protoTx.GetFee(),
@@ -11,6 +11,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -21,17 +24,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
+// MaxSimulateBundleTxs is the maximum number of txs of a SimulateBundle
+// request.
+const MaxSimulateBundleTxs = 16
+
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateBundleFn is the signature of the Baseapp#SimulateBundle
+// function.
+type baseAppSimulateBundleFn func(txs [][]byte, overrides txtypes.StateOverrides, verifySignatures bool) ([]baseapp.BundleTxResult, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateBundle    baseAppSimulateBundleFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
-// NewTxServer creates a new Tx service server.
+// NewTxServer creates a new Tx service server. Its SimulateBundle method is
+// not implemented, see NewTxServerWithSimulateBundle.
 func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
@@ -40,6 +53,17 @@ func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interface
 	}
 }
 
+// NewTxServerWithSimulateBundle creates a new Tx service server simulating the
+// bundles of txs with simulateBundle, e.g. the Baseapp#SimulateBundle function.
+func NewTxServerWithSimulateBundle(clientCtx client.Context, simulate baseAppSimulateFn, simulateBundle baseAppSimulateBundleFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+	return txServer{
+		clientCtx:         clientCtx,
+		simulate:          simulate,
+		simulateBundle:    simulateBundle,
+		interfaceRegistry: interfaceRegistry,
+	}
+}
+
 var _ txtypes.ServiceServer = txServer{}
 
 // GetTxsEvent implements the ServiceServer.TxsByEvents RPC method.
@@ -106,6 +130,66 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateBundle implements the ServiceServer.SimulateBundle RPC method.
+func (s txServer) SimulateBundle(ctx context.Context, req *txtypes.SimulateBundleRequest) (*txtypes.SimulateBundleResponse, error) {
+	if s.simulateBundle == nil {
+		return nil, status.Error(codes.Unimplemented, "bundle simulation is not supported")
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxsBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bundle is not allowed")
+	}
+
+	if len(req.TxsBytes) > MaxSimulateBundleTxs {
+		return nil, status.Errorf(codes.InvalidArgument, "bundle of %d txs exceeds the maximum of %d", len(req.TxsBytes), MaxSimulateBundleTxs)
+	}
+
+	for i, txBytes := range req.TxsBytes {
+		if len(txBytes) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty txBytes at index %d is not allowed", i)
+		}
+	}
+
+	results, err := s.simulateBundle(req.TxsBytes, req.Overrides, !req.SkipSignatureVerification)
+	if err != nil {
+		return nil, simulateBundleErrorToGRPC(err)
+	}
+
+	res := &txtypes.SimulateBundleResponse{Results: make([]*txtypes.SimulateBundleTxResult, len(results))}
+	for i, result := range results {
+		gasInfo := result.GasInfo
+		res.Results[i] = &txtypes.SimulateBundleTxResult{
+			GasInfo: &gasInfo,
+			Result:  result.Result,
+		}
+
+		if result.Err != nil {
+			res.Results[i].Codespace, res.Results[i].Code, res.Results[i].Log = errorsmod.ABCIInfo(result.Err, false)
+		}
+	}
+
+	return res, nil
+}
+
+// simulateBundleErrorToGRPC converts an error of the bundle simulation to a
+// gRPC status error by its ABCI code: the unsupported overrides are
+// Unimplemented, the other registered errors are caused by the request.
+func simulateBundleErrorToGRPC(err error) error {
+	codespace, code, log := errorsmod.ABCIInfo(err, false)
+	switch {
+	case codespace == sdkerrors.ErrNotSupported.Codespace() && code == sdkerrors.ErrNotSupported.ABCICode():
+		return status.Error(codes.Unimplemented, log)
+	case codespace == errorsmod.UndefinedCodespace:
+		return status.Error(codes.Internal, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, log)
+	}
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -317,6 +401,21 @@ func RegisterTxService(
 	)
 }
 
+// RegisterTxServiceWithSimulateBundle registers the tx service on the gRPC
+// router, simulating the bundles of txs with simulateBundleFn.
+func RegisterTxServiceWithSimulateBundle(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	simulateFn baseAppSimulateFn,
+	simulateBundleFn baseAppSimulateBundleFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	txtypes.RegisterServiceServer(
+		qrt,
+		NewTxServerWithSimulateBundle(clientCtx, simulateFn, simulateBundleFn, interfaceRegistry),
+	)
+}
+
 // RegisterGRPCGatewayRoutes mounts the tx service's GRPC-gateway routes on the
 // given Mux.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
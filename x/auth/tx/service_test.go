@@ -0,0 +1,85 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestSimulateBundle(t *testing.T) {
+	var (
+		gotTxs    [][]byte
+		gotVerify bool
+	)
+	simulateBundle := func(txs [][]byte, overrides txtypes.StateOverrides, verifySignatures bool) ([]baseapp.BundleTxResult, error) {
+		gotTxs, gotVerify = txs, verifySignatures
+		return []baseapp.BundleTxResult{
+			{GasInfo: sdk.GasInfo{GasWanted: 10, GasUsed: 5}, Result: &sdk.Result{Log: "ok"}},
+			{GasInfo: sdk.GasInfo{GasWanted: 10, GasUsed: 7}, Err: sdkerrors.ErrInsufficientFunds.Wrap("not enough")},
+		}, nil
+	}
+	server := NewTxServerWithSimulateBundle(client.Context{}, nil, simulateBundle, codectypes.NewInterfaceRegistry())
+
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	res, err := server.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs})
+	require.NoError(t, err)
+	require.Equal(t, txs, gotTxs)
+	require.True(t, gotVerify)
+	require.Len(t, res.Results, 2)
+	require.Equal(t, &txtypes.SimulateBundleTxResult{
+		GasInfo: &sdk.GasInfo{GasWanted: 10, GasUsed: 5},
+		Result:  &sdk.Result{Log: "ok"},
+	}, res.Results[0])
+	require.Equal(t, &txtypes.SimulateBundleTxResult{
+		GasInfo:   &sdk.GasInfo{GasWanted: 10, GasUsed: 7},
+		Codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+		Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+		Log:       "not enough: insufficient funds",
+	}, res.Results[1])
+
+	_, err = server.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs, SkipSignatureVerification: true})
+	require.NoError(t, err)
+	require.False(t, gotVerify)
+
+	// the errors of the bundle are mapped by their ABCI code
+	for bundleErr, code := range map[error]codes.Code{
+		sdkerrors.ErrInvalidRequest.Wrap("unknown store"):   codes.InvalidArgument,
+		sdkerrors.ErrNotSupported.Wrap("account overrides"): codes.Unimplemented,
+		errors.New("store failure"):                         codes.Internal,
+	} {
+		bundleErr := bundleErr
+		simulateBundle := func([][]byte, txtypes.StateOverrides, bool) ([]baseapp.BundleTxResult, error) {
+			return nil, bundleErr
+		}
+		server := NewTxServerWithSimulateBundle(client.Context{}, nil, simulateBundle, codectypes.NewInterfaceRegistry())
+		_, err = server.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs})
+		require.Equal(t, code, status.Code(err), bundleErr.Error())
+		require.Equal(t, bundleErr.Error(), status.Convert(err).Message())
+	}
+
+	for name, req := range map[string]*txtypes.SimulateBundleRequest{
+		"nil request":  nil,
+		"empty bundle": {},
+		"empty tx":     {TxsBytes: [][]byte{[]byte("tx1"), nil}},
+		"too many txs": {TxsBytes: make([][]byte, MaxSimulateBundleTxs+1)},
+	} {
+		_, err := server.SimulateBundle(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	// the bundles are not simulated by the servers created with NewTxServer
+	server = NewTxServer(client.Context{}, nil, codectypes.NewInterfaceRegistry())
+	_, err = server.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
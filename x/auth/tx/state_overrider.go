@@ -0,0 +1,52 @@
+package tx
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StateOverriderAccountKeeper defines the account keeper methods used by the
+// StateOverrider of NewStateOverrider.
+type StateOverriderAccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) sdk.AccountI
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx sdk.Context, acc sdk.AccountI)
+}
+
+// StateOverriderBankKeeper defines the bank keeper methods used by the
+// StateOverrider of NewStateOverrider. They are implemented by the x/bank
+// BaseKeeper, not by the bank Keeper interface.
+type StateOverriderBankKeeper interface {
+	OverrideBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
+}
+
+type stateOverrider struct {
+	ak StateOverriderAccountKeeper
+	bk StateOverriderBankKeeper
+}
+
+// NewStateOverrider returns the baseapp.StateOverrider applying the account
+// overrides of the bundle simulations with the x/auth and x/bank keepers.
+func NewStateOverrider(ak StateOverriderAccountKeeper, bk StateOverriderBankKeeper) baseapp.StateOverrider {
+	return stateOverrider{ak: ak, bk: bk}
+}
+
+// OverrideBalances implements baseapp.StateOverrider.
+func (so stateOverrider) OverrideBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	return so.bk.OverrideBalances(ctx, addr, balances)
+}
+
+// OverrideSequence implements baseapp.StateOverrider.
+func (so stateOverrider) OverrideSequence(ctx sdk.Context, addr sdk.AccAddress, sequence uint64) error {
+	acc := so.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = so.ak.NewAccountWithAddress(ctx, addr)
+	}
+
+	if err := acc.SetSequence(sequence); err != nil {
+		return err
+	}
+
+	so.ak.SetAccount(ctx, acc)
+	return nil
+}
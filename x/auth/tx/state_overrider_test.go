@@ -0,0 +1,42 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type mapAccountKeeper map[string]sdk.AccountI
+
+func (ak mapAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) sdk.AccountI {
+	return ak[addr.String()]
+}
+
+func (ak mapAccountKeeper) NewAccountWithAddress(_ sdk.Context, addr sdk.AccAddress) sdk.AccountI {
+	acc := authtypes.NewBaseAccountWithAddress(addr)
+	_ = acc.SetAccountNumber(uint64(len(ak)))
+	return acc
+}
+
+func (ak mapAccountKeeper) SetAccount(_ sdk.Context, acc sdk.AccountI) {
+	ak[acc.GetAddress().String()] = acc
+}
+
+func TestStateOverrider_OverrideSequence(t *testing.T) {
+	existing := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("existing"))
+	require.NoError(t, existing.SetAccountNumber(7))
+	ak := mapAccountKeeper{existing.Address: existing}
+	so := NewStateOverrider(ak, nil)
+
+	require.NoError(t, so.OverrideSequence(sdk.Context{}, existing.GetAddress(), 3))
+	require.Equal(t, uint64(3), ak[existing.Address].GetSequence())
+	require.Equal(t, uint64(7), ak[existing.Address].GetAccountNumber())
+
+	// a missing account is created
+	addr := sdk.AccAddress("new")
+	require.NoError(t, so.OverrideSequence(sdk.Context{}, addr, 5))
+	require.Equal(t, uint64(5), ak[addr.String()].GetSequence())
+}
@@ -27,6 +27,12 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// UnorderedTxHashPrefix prefix for the timeout heights of the unordered txs by hash
+	UnorderedTxHashPrefix = []byte{0x02}
+
+	// UnorderedTxTimeoutPrefix prefix for the hashes of the unordered txs by timeout height
+	UnorderedTxTimeoutPrefix = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -38,3 +44,14 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// UnorderedTxHashKey returns the key of the timeout height of an unordered tx.
+func UnorderedTxHashKey(hash []byte) []byte {
+	return append(UnorderedTxHashPrefix, hash...)
+}
+
+// UnorderedTxTimeoutKey returns the key of an unordered tx by timeout height.
+func UnorderedTxTimeoutKey(timeout uint64, hash []byte) []byte {
+	key := append(UnorderedTxTimeoutPrefix, sdk.Uint64ToBigEndian(timeout)...)
+	return append(key, hash...)
+}
@@ -415,6 +415,24 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 	return nil
 }
 
+// OverrideBalances sets the balances of addr in the denoms of balances, a
+// zero amount removing a balance, and adjusts the supply of the denoms by the
+// difference. It bypasses the send restrictions, the locked coins and the
+// events, and is meant for the state overrides of the simulations only.
+func (k BaseKeeper) OverrideBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	for _, balance := range balances {
+		prev := k.GetBalance(ctx, addr, balance.Denom)
+		if err := k.setBalance(ctx, addr, balance); err != nil {
+			return err
+		}
+
+		supply := k.GetSupply(ctx, balance.Denom)
+		k.setSupply(ctx, sdk.NewCoin(balance.Denom, supply.Amount.Sub(prev.Amount).Add(balance.Amount)))
+	}
+
+	return nil
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	// Bank invariants and IBC requires to remove zero coins.
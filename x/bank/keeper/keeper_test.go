@@ -462,6 +462,31 @@ func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	require.Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+func (suite *KeeperTestSuite) TestOverrideBalances() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	initialSupply, _, err := suite.bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	require.NoError(err)
+
+	// the unlisted denoms are left untouched, a zero amount removes a balance
+	require.NoError(suite.bankKeeper.OverrideBalances(ctx, accAddrs[0], sdk.Coins{newFooCoin(30), newBarCoin(0)}))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	require.NoError(suite.bankKeeper.OverrideBalances(ctx, accAddrs[1], sdk.NewCoins(newBarCoin(20))))
+	require.Equal(sdk.NewCoins(newBarCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the supply follows the balances
+	supply, _, err := suite.bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	require.NoError(err)
+	require.Equal(initialSupply.Sub(newFooCoin(70), newBarCoin(30)), supply)
+
+	require.Error(suite.bankKeeper.OverrideBalances(ctx, accAddrs[0], sdk.Coins{sdk.Coin{Denom: fooDenom, Amount: math.NewInt(-1)}}))
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -57,3 +57,28 @@ func (k *Keeper) Set(ctx sdk.Context, cp *cmtproto.ConsensusParams) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.ParamStoreKeyConsensusParams, k.cdc.MustMarshal(cp))
 }
+
+// GetGasSchedule gets the gas schedule of the KVStores, or the default one if
+// it is not set.
+func (k *Keeper) GetGasSchedule(ctx sdk.Context) (sdk.GasSchedule, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamStoreKeyGasSchedule)
+	if bz == nil {
+		return sdk.DefaultGasSchedule(), nil
+	}
+
+	var schedule types.GasSchedule
+	if err := k.cdc.Unmarshal(bz, &schedule); err != nil {
+		return sdk.GasSchedule{}, err
+	}
+
+	return schedule.ToSDKGasSchedule(), nil
+}
+
+// SetGasSchedule sets the gas schedule of the KVStores
+func (k *Keeper) SetGasSchedule(ctx sdk.Context, schedule sdk.GasSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	gs := types.NewGasSchedule(schedule)
+	store.Set(types.ParamStoreKeyGasSchedule, k.cdc.MustMarshal(&gs))
+}
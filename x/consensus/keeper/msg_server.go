@@ -40,3 +40,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) UpdateGasSchedule(goCtx context.Context, req *types.MsgUpdateGasSchedule) (*types.MsgUpdateGasScheduleResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule := req.GasSchedule.ToSDKGasSchedule()
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetGasSchedule(ctx, schedule)
+
+	return &types.MsgUpdateGasScheduleResponse{}, nil
+}
@@ -3,6 +3,10 @@ package keeper_test
 import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
 
@@ -63,3 +67,67 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateGasSchedule() {
+	schedule := sdk.DefaultGasSchedule()
+	staking := storetypes.KVGasConfig()
+	staking.WriteCostFlat *= 10
+	schedule.Stores = []sdk.StoreGasConfig{{StoreKey: "staking", GasConfig: staking}}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateGasSchedule
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid gas schedule",
+			input: &types.MsgUpdateGasSchedule{
+				Authority:   s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.NewGasSchedule(schedule),
+			},
+			expErr: false,
+		},
+		{
+			name: "empty default gas config",
+			input: &types.MsgUpdateGasSchedule{
+				Authority:   s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{Stores: types.NewGasSchedule(schedule).Stores},
+			},
+			expErr:    true,
+			expErrMsg: "default gas config cannot be empty",
+		},
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateGasSchedule{
+				Authority:   "invalid",
+				GasSchedule: types.NewGasSchedule(schedule),
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.msgServer.UpdateGasSchedule(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+
+				// the default gas schedule is kept
+				got, err := s.consensusParamsKeeper.GetGasSchedule(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.DefaultGasSchedule(), got)
+			} else {
+				s.Require().NoError(err)
+
+				got, err := s.consensusParamsKeeper.GetGasSchedule(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(schedule, got)
+			}
+		})
+	}
+}
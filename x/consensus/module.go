@@ -164,6 +164,7 @@ func ProvideModule(in ConsensusInputs) ConsensusOutputs {
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
 		app.SetParamStore(&k)
+		app.SetGasScheduleStore(&k)
 	}
 
 	return ConsensusOutputs{
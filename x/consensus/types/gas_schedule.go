@@ -0,0 +1,53 @@
+package types
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGasSchedule returns the GasSchedule stored by x/consensus for the gas
+// schedule of the KVStores.
+func NewGasSchedule(schedule sdk.GasSchedule) GasSchedule {
+	stores := make([]StoreGasConfig, len(schedule.Stores))
+	for i, store := range schedule.Stores {
+		stores[i] = StoreGasConfig{StoreKey: store.StoreKey, GasConfig: newGasConfig(store.GasConfig)}
+	}
+
+	return GasSchedule{Default: newGasConfig(schedule.Default), Stores: stores}
+}
+
+// ToSDKGasSchedule returns the gas schedule of the KVStores charged by the
+// BaseApp.
+func (s GasSchedule) ToSDKGasSchedule() sdk.GasSchedule {
+	var stores []sdk.StoreGasConfig
+	for _, store := range s.Stores {
+		stores = append(stores, sdk.StoreGasConfig{StoreKey: store.StoreKey, GasConfig: store.GasConfig.toStoreGasConfig()})
+	}
+
+	return sdk.GasSchedule{Default: s.Default.toStoreGasConfig(), Stores: stores}
+}
+
+func newGasConfig(c storetypes.GasConfig) GasConfig {
+	return GasConfig{
+		HasCost:          c.HasCost,
+		DeleteCost:       c.DeleteCost,
+		ReadCostFlat:     c.ReadCostFlat,
+		ReadCostPerByte:  c.ReadCostPerByte,
+		WriteCostFlat:    c.WriteCostFlat,
+		WriteCostPerByte: c.WriteCostPerByte,
+		IterNextCostFlat: c.IterNextCostFlat,
+	}
+}
+
+func (c GasConfig) toStoreGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          c.HasCost,
+		DeleteCost:       c.DeleteCost,
+		ReadCostFlat:     c.ReadCostFlat,
+		ReadCostPerByte:  c.ReadCostPerByte,
+		WriteCostFlat:    c.WriteCostFlat,
+		WriteCostPerByte: c.WriteCostPerByte,
+		IterNextCostFlat: c.IterNextCostFlat,
+	}
+}
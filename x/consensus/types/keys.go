@@ -8,4 +8,7 @@ const (
 	StoreKey = ModuleName
 )
 
-var ParamStoreKeyConsensusParams = []byte("Consensus")
+var (
+	ParamStoreKeyConsensusParams = []byte("Consensus")
+	ParamStoreKeyGasSchedule     = []byte("GasSchedule")
+)
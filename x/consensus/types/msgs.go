@@ -11,6 +11,8 @@ import (
 var (
 	_ sdk.Msg            = &MsgUpdateParams{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ sdk.Msg            = &MsgUpdateGasSchedule{}
+	_ legacytx.LegacyMsg = &MsgUpdateGasSchedule{}
 )
 
 // GetSigners returns the signer addresses that are expected to sign the result
@@ -49,3 +51,21 @@ func (msg MsgUpdateParams) ToProtoConsensusParams() cmtproto.ConsensusParams {
 		Version: cmttypes.DefaultConsensusParams().ToProto().Version, // Version is stored in x/upgrade
 	}
 }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateGasSchedule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateGasSchedule message that
+// the expected signer needs to sign.
+func (msg MsgUpdateGasSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs basic MsgUpdateGasSchedule message validation.
+func (msg MsgUpdateGasSchedule) ValidateBasic() error {
+	return msg.GasSchedule.ToSDKGasSchedule().Validate()
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -144,40 +145,149 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateGasSchedule is the Msg/UpdateGasSchedule request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGasSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// gas_schedule defines the gas schedule of the KVStores to set.
+	//
+	// NOTE: The whole schedule must be supplied, including its default gas
+	// configuration.
+	GasSchedule GasSchedule `protobuf:"bytes,2,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+}
+
+func (m *MsgUpdateGasSchedule) Reset()         { *m = MsgUpdateGasSchedule{} }
+func (m *MsgUpdateGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasSchedule) ProtoMessage()    {}
+func (*MsgUpdateGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgUpdateGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasSchedule.Merge(m, src)
+}
+func (m *MsgUpdateGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateGasSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGasSchedule) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
+// MsgUpdateGasScheduleResponse defines the response structure for executing a
+// MsgUpdateGasSchedule message.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGasScheduleResponse struct {
+}
+
+func (m *MsgUpdateGasScheduleResponse) Reset()         { *m = MsgUpdateGasScheduleResponse{} }
+func (m *MsgUpdateGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateGasSchedule)(nil), "cosmos.consensus.v1.MsgUpdateGasSchedule")
+	proto.RegisterType((*MsgUpdateGasScheduleResponse)(nil), "cosmos.consensus.v1.MsgUpdateGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0xc1, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x9d, 0x94, 0x24,
-	0x44, 0x5d, 0x3c, 0x98, 0xa7, 0x0f, 0xd5, 0x04, 0x91, 0x12, 0x87, 0x5a, 0x90, 0x5b, 0x9c, 0x0e,
-	0x32, 0x3a, 0xb7, 0x38, 0x1d, 0x2a, 0x21, 0x5b, 0x92, 0x9a, 0x97, 0x92, 0x5a, 0x94, 0x9b, 0x99,
-	0x57, 0xa2, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0x5f, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xd5, 0xa7,
-	0xb4, 0x83, 0x89, 0x8b, 0xdf, 0xb7, 0x38, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x00, 0x2c,
-	0x23, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x42, 0xc7, 0x94, 0x94, 0xa2,
-	0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x63, 0x2e, 0xd6,
-	0xa4, 0x9c, 0xfc, 0xe4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x59, 0x3d, 0x84, 0xd5,
-	0x7a, 0x60, 0xab, 0xf5, 0x9c, 0x40, 0xd2, 0x10, 0x5b, 0x82, 0x20, 0x6a, 0x85, 0x6c, 0xb8, 0x38,
-	0x52, 0xcb, 0x32, 0x53, 0x52, 0xf3, 0x92, 0x53, 0x25, 0x98, 0xc1, 0xfa, 0x14, 0x30, 0xf5, 0xb9,
-	0x42, 0x55, 0x40, 0xb5, 0xc2, 0x75, 0x08, 0xd9, 0x73, 0x71, 0x96, 0x25, 0xe6, 0x64, 0xa6, 0x24,
-	0x96, 0xe4, 0x17, 0x49, 0xb0, 0x80, 0xb5, 0x2b, 0x62, 0x6a, 0x0f, 0x83, 0x29, 0x81, 0xea, 0x47,
-	0xe8, 0xb1, 0xb2, 0x6c, 0x7a, 0xbe, 0x41, 0x0b, 0xe1, 0x87, 0xae, 0xe7, 0x1b, 0xb4, 0xd4, 0x20,
-	0xfe, 0xd4, 0x2d, 0x4e, 0xc9, 0xd6, 0xaf, 0x40, 0x8a, 0x31, 0xb4, 0x60, 0x52, 0x92, 0xe4, 0x12,
-	0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0x00, 0x29, 0x37, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17,
-	0x4a, 0xe2, 0xe2, 0x41, 0x09, 0x58, 0x15, 0x3d, 0x2c, 0x11, 0xad, 0x87, 0x66, 0x88, 0x94, 0x0e,
-	0x31, 0xaa, 0x60, 0x56, 0x49, 0xb1, 0x36, 0x3c, 0xdf, 0xa0, 0xc5, 0xe8, 0xe4, 0x71, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0xf0, 0x54, 0x88, 0xd5, 0x83, 0xe0, 0xc0, 0x4a, 0x62, 0x03, 0x27, 0x0c,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x2c, 0x44, 0x5c, 0xb3, 0x02, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x6b, 0x13, 0x41,
+	0x1c, 0xcd, 0xb6, 0x56, 0xcc, 0xb4, 0x20, 0x5d, 0x03, 0xdd, 0x2e, 0xed, 0x1a, 0xe3, 0x1f, 0x6a,
+	0xb0, 0x33, 0xa4, 0x05, 0xd1, 0x22, 0x88, 0x01, 0xd1, 0x4b, 0x45, 0x52, 0xf4, 0xe0, 0xa5, 0x4c,
+	0x76, 0x87, 0xc9, 0xd2, 0xec, 0xce, 0xba, 0xbf, 0x49, 0x68, 0x6f, 0xe2, 0xd1, 0x93, 0x1f, 0xc3,
+	0x63, 0x0e, 0x1e, 0xfc, 0x08, 0x3d, 0x16, 0x4f, 0x3d, 0x89, 0x24, 0x87, 0x7c, 0x0d, 0xd9, 0xd9,
+	0xd9, 0x6c, 0x4c, 0x96, 0x12, 0x7a, 0x59, 0x96, 0x79, 0xef, 0xfd, 0x7e, 0xef, 0x3d, 0x66, 0xd0,
+	0x96, 0x2b, 0x20, 0x10, 0x40, 0x5c, 0x11, 0x02, 0x0b, 0xa1, 0x07, 0xa4, 0xdf, 0x20, 0xf2, 0x14,
+	0x47, 0xb1, 0x90, 0xc2, 0xbc, 0x93, 0xa2, 0x78, 0x82, 0xe2, 0x7e, 0xc3, 0x5e, 0xa7, 0x81, 0x1f,
+	0x0a, 0xa2, 0xbe, 0x29, 0xcf, 0xae, 0x70, 0xc1, 0x85, 0xfa, 0x25, 0xc9, 0x9f, 0x3e, 0xdd, 0x4c,
+	0xd5, 0xc7, 0x29, 0xa0, 0x47, 0xa5, 0xd0, 0x86, 0x5e, 0x1b, 0x00, 0x4f, 0x16, 0x06, 0xc0, 0x35,
+	0xb0, 0x2d, 0x59, 0xe8, 0xb1, 0x38, 0xf0, 0x43, 0x49, 0xe4, 0x59, 0xc4, 0x80, 0x44, 0x34, 0xa6,
+	0x41, 0xa6, 0xbb, 0x5f, 0x64, 0x37, 0x77, 0xa7, 0x48, 0xb5, 0x5f, 0x4b, 0xe8, 0xf6, 0x21, 0xf0,
+	0x0f, 0x91, 0x47, 0x25, 0x7b, 0xaf, 0xe4, 0xe6, 0x53, 0x54, 0xa6, 0x3d, 0xd9, 0x11, 0xb1, 0x2f,
+	0xcf, 0x2c, 0xa3, 0x6a, 0xec, 0x94, 0x9b, 0xd6, 0xef, 0x9f, 0xbb, 0x15, 0xed, 0xea, 0x95, 0xe7,
+	0xc5, 0x0c, 0xe0, 0x48, 0xc6, 0x7e, 0xc8, 0x5b, 0x39, 0xd5, 0xdc, 0x47, 0x2b, 0xed, 0xae, 0x70,
+	0x4f, 0xac, 0xa5, 0xaa, 0xb1, 0xb3, 0xba, 0xb7, 0x8d, 0x73, 0x7f, 0x58, 0xf9, 0xc3, 0xcd, 0x04,
+	0x4e, 0xb7, 0xb4, 0x52, 0xae, 0xf9, 0x02, 0xdd, 0x62, 0x7d, 0xdf, 0x63, 0xa1, 0xcb, 0xac, 0x65,
+	0xa5, 0xab, 0xce, 0xeb, 0x5e, 0x6b, 0x86, 0x96, 0x4e, 0x14, 0xe6, 0x4b, 0x54, 0xee, 0xd3, 0xae,
+	0xef, 0x51, 0x29, 0x62, 0xeb, 0x86, 0x92, 0xdf, 0x9b, 0x97, 0x7f, 0xcc, 0x28, 0x5a, 0x9f, 0x6b,
+	0x0e, 0x9e, 0x7f, 0x1d, 0x0f, 0xea, 0x79, 0x86, 0x6f, 0xe3, 0x41, 0xfd, 0x51, 0x9a, 0x73, 0x17,
+	0xbc, 0x13, 0x72, 0x3a, 0xd5, 0xde, 0x4c, 0x4d, 0xb5, 0x4d, 0xb4, 0x31, 0x73, 0xd4, 0x62, 0x10,
+	0x25, 0xf4, 0xda, 0xa5, 0x81, 0x2a, 0x13, 0xec, 0x0d, 0x85, 0x23, 0xb7, 0xc3, 0xbc, 0x5e, 0x97,
+	0x5d, 0xbb, 0xda, 0x77, 0x68, 0x8d, 0x53, 0x38, 0x06, 0x3d, 0x47, 0x37, 0x5c, 0xc5, 0x05, 0x77,
+	0x0e, 0x4f, 0xed, 0x6b, 0x96, 0xcf, 0xff, 0xdc, 0x2d, 0xfd, 0x18, 0x0f, 0xea, 0x46, 0x6b, 0x95,
+	0xe7, 0xe7, 0x07, 0xcf, 0xe6, 0x63, 0x3f, 0xbc, 0x32, 0x76, 0x36, 0xb4, 0xe6, 0xa0, 0xad, 0xa2,
+	0x64, 0x59, 0xf4, 0xbd, 0x91, 0x81, 0x96, 0x0f, 0x81, 0x9b, 0x6d, 0xb4, 0xf6, 0xdf, 0xa5, 0x7a,
+	0x50, 0xe8, 0x75, 0xa6, 0x40, 0xfb, 0xc9, 0x22, 0xac, 0x6c, 0x97, 0xf9, 0x19, 0xad, 0xcf, 0x57,
+	0xfc, 0xf8, 0xea, 0x11, 0x53, 0x54, 0xbb, 0xb1, 0x30, 0x35, 0x5b, 0x69, 0xaf, 0x7c, 0x49, 0xca,
+	0x6c, 0xbe, 0x3d, 0x1f, 0x3a, 0xc6, 0xc5, 0xd0, 0x31, 0xfe, 0x0e, 0x1d, 0xe3, 0xfb, 0xc8, 0x29,
+	0x5d, 0x8c, 0x9c, 0xd2, 0xe5, 0xc8, 0x29, 0x7d, 0xc2, 0xdc, 0x97, 0x9d, 0x5e, 0x1b, 0xbb, 0x22,
+	0x20, 0x93, 0x07, 0x58, 0x58, 0xac, 0xba, 0x9b, 0xed, 0x9b, 0xea, 0x1d, 0xee, 0xff, 0x1b, 0x00,
+	0x8d, 0x6e, 0xb1, 0x10, 0x5d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -197,6 +307,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule of the KVStores. The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -216,6 +331,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error) {
+	out := new(MsgUpdateGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/UpdateGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus_param module parameters.
@@ -223,6 +347,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule of the KVStores. The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGasSchedule(context.Context, *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -232,6 +361,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGasSchedule(ctx context.Context, req *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -255,6 +387,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/UpdateGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, req.(*MsgUpdateGasSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -263,6 +413,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasSchedule",
+			Handler:    _Msg_UpdateGasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -357,6 +511,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -402,6 +619,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -648,6 +889,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,3 +28,13 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// RefundableFeeAllowanceI is a FeeAllowance able to give back a part of a fee
+// it accepted, when the fee is partly refunded after the execution of the
+// transaction. See Keeper.RefundGrantedFees.
+type RefundableFeeAllowanceI interface {
+	FeeAllowanceI
+
+	// Refund restores the given part of a fee accepted by the allowance.
+	Refund(refund sdk.Coins) error
+}
@@ -19,6 +19,7 @@ const (
 
 var (
 	_ FeeAllowanceI                 = (*AllowedMsgAllowance)(nil)
+	_ RefundableFeeAllowanceI       = (*AllowedMsgAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*AllowedMsgAllowance)(nil)
 )
 
@@ -86,6 +87,25 @@ func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.
 	return remove, err
 }
 
+// Refund refunds the inner allowance, if it can refund.
+func (a *AllowedMsgAllowance) Refund(refund sdk.Coins) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := allowance.(RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(refund); err != nil {
+		return err
+	}
+
+	return a.SetAllowance(refundable)
+}
+
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
 	msgsMap := make(map[string]bool, len(a.AllowedMessages))
 	for _, msg := range a.AllowedMessages {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -235,6 +236,37 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// RefundGrantedFees gives back to the allowance granted by granter to grantee
+// the part of a fee, paid with UseGrantedFees, refunded after the execution of
+// the transaction. It is a no-op if the allowance cannot refund, or if it was
+// removed for being used up by the fee.
+func (k Keeper) RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := grant.(feegrant.RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(refund); err != nil {
+		return err
+	}
+
+	return k.UpdateAllowance(ctx, granter, grantee, refundable)
+}
+
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -17,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type KeeperTestSuite struct {
@@ -288,6 +290,96 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	blockTime := suite.ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	oneHour := time.Hour
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           oneHour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		PeriodReset:      blockTime.Add(oneHour),
+	}
+	filtered, err := feegrant.NewAllowedMsgAllowance(basic, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	suite.Require().NoError(err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 30))
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coins
+		final     func() feegrant.FeeAllowanceI
+	}{
+		"basic": {
+			allowance: basic,
+			fee:       fee,
+			final: func() feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 545)), Expiration: &oneYear}
+			},
+		},
+		"periodic": {
+			allowance: periodic,
+			fee:       fee,
+			final: func() feegrant.FeeAllowanceI {
+				final := *periodic
+				final.Basic.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("atom", 545))
+				final.PeriodCanSpend = sdk.NewCoins(sdk.NewInt64Coin("atom", 90))
+				return &final
+			},
+		},
+		"filtered": {
+			allowance: filtered,
+			fee:       fee,
+			final: func() feegrant.FeeAllowanceI {
+				final, err := feegrant.NewAllowedMsgAllowance(
+					&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 545)), Expiration: &oneYear},
+					[]string{"/cosmos.bank.v1beta1.MsgSend"},
+				)
+				suite.Require().NoError(err)
+				return final
+			},
+		},
+		"used up": {
+			allowance: basic,
+			fee:       suite.atom,
+			final:     func() feegrant.FeeAllowanceI { return nil },
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], tc.allowance)
+			suite.Require().NoError(err)
+
+			msgs := []sdk.Msg{&banktypes.MsgSend{}}
+			err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], tc.fee, msgs)
+			suite.Require().NoError(err)
+
+			err = suite.feegrantKeeper.RefundGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], refund)
+			suite.Require().NoError(err)
+
+			loaded, _ := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+			suite.Require().Equal(tc.final(), loaded)
+
+			if loaded != nil {
+				_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+					Granter: suite.addrs[0].String(),
+					Grantee: suite.addrs[1].String(),
+				})
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.BlockTime().AddDate(1, 0, 0)
@@ -9,7 +9,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*PeriodicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*PeriodicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*PeriodicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -49,6 +52,14 @@ func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg)
 	return false, nil
 }
 
+// Refund adds the refund back to the amount that can be spent in the current
+// period, up to the period spend limit, and to the spend limit, if any.
+func (a *PeriodicAllowance) Refund(refund sdk.Coins) error {
+	a.PeriodCanSpend = a.PeriodCanSpend.Add(refund...).Min(a.PeriodSpendLimit)
+
+	return a.Basic.Refund(refund)
+}
+
 // tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
 // If we hit the reset period, it will top up the PeriodCanSpend amount to
 // min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetBaseFee returns the base fee of the next block, in the denom of the
+// minimum base fee. It is never lower than the minimum base fee.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoin {
+	return k.baseFee(ctx, k.GetParams(ctx))
+}
+
+func (k Keeper) baseFee(ctx sdk.Context, params types.Params) sdk.DecCoin {
+	baseFee := params.MinBaseFee
+
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return baseFee
+	}
+
+	var amount sdk.Dec
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	baseFee.Amount = sdk.MaxDec(amount, baseFee.Amount)
+
+	return baseFee
+}
+
+// SetBaseFee sets the base fee of the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the
+// current block, and returns it.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	baseFee := types.NextBaseFee(k.GetParams(ctx), k.GetBaseFee(ctx).Amount, gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+
+	return baseFee
+}
+
+// CheckTxFee implements the ante.TxFeeChecker function type, so it can be
+// given to ante.NewDeductFeeDecorator in place of the validator minimum gas
+// prices. It requires the fee of a transaction to be at least its gas limit
+// times the base fee, in the denom of the base fee, in CheckTx and DeliverTx
+// alike. The priority of the transaction is its gas price in this denom. The
+// whole fee is deducted, the base fee is not burnt.
+//
+// The genesis transactions are not checked, the base fee being set after them.
+// An error is returned if the params are not set or invalid.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee, gas := feeTx.GetFee(), feeTx.GetGas()
+	if ctx.BlockHeight() == 0 {
+		return fee, 0, nil
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.Validate(); err != nil {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrLogic, "invalid %s params: %s", types.ModuleName, err)
+	}
+
+	baseFee := k.baseFee(ctx, params)
+	gasLimit := sdk.NewDecFromInt(sdkmath.NewIntFromUint64(gas))
+	requiredFee := sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(gasLimit).Ceil().RoundInt())
+
+	paid := fee.AmountOf(baseFee.Denom)
+	if paid.LT(requiredFee.Amount) {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFee)
+	}
+
+	return fee, gasPrice(paid, gas), nil
+}
+
+// gasPrice returns the price of a unit of gas, truncated to an int64.
+func gasPrice(fee sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	price := fee.Quo(sdkmath.NewIntFromUint64(gas))
+	if !price.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return price.Int64()
+}
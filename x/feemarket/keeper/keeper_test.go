@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	feemarketKeeper keeper.Keeper
+	storeKey        storetypes.StoreKey
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	txConfig        client.TxConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(1)
+	s.storeKey = key
+	s.txConfig = encCfg.TxConfig
+
+	s.feemarketKeeper = keeper.NewKeeper(encCfg.Codec, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.msgServer = keeper.NewMsgServerImpl(s.feemarketKeeper)
+
+	params := types.NewParams(1000, sdk.NewDecWithPrec(5, 1), sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestBaseFee() {
+	// the base fee defaults to the min base fee
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)), s.feemarketKeeper.GetBaseFee(s.ctx))
+
+	s.feemarketKeeper.SetBaseFee(s.ctx, sdk.NewDec(2))
+	s.Require().Equal(sdk.NewDec(3), s.feemarketKeeper.UpdateBaseFee(s.ctx, 2000))
+	s.Require().Equal(sdk.NewDecWithPrec(225, 2), s.feemarketKeeper.UpdateBaseFee(s.ctx, 500))
+
+	res, err := s.feemarketKeeper.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(225, 2)), res.BaseFee)
+
+	// a base fee below a raised min base fee is raised too
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	params.MinBaseFee.Amount = sdk.NewDec(5)
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+	s.Require().Equal(sdk.NewDec(5), s.feemarketKeeper.GetBaseFee(s.ctx).Amount)
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	s.feemarketKeeper.SetBaseFee(s.ctx, sdk.NewDecWithPrec(15, 1))
+
+	unsetParamsCtx, _ := s.ctx.CacheContext()
+	unsetParamsCtx.KVStore(s.storeKey).Delete(types.ParamsKey)
+
+	newTx := func(fee sdk.Coins, gas uint64) sdk.Tx {
+		txBuilder := s.txConfig.NewTxBuilder()
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name        string
+		ctx         sdk.Context
+		fee         sdk.Coins
+		gas         uint64
+		expPriority int64
+		expErr      error
+	}{
+		{"enough fee", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), 100, 1, nil},
+		{"higher gas price", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 300), sdk.NewInt64Coin("atom", 1)), 100, 3, nil},
+		{"rounded up", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), 1, 2, nil},
+		{"insufficient fee", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 149)), 100, 0, sdkerrors.ErrInsufficientFee},
+		{"wrong denom", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 100, 0, sdkerrors.ErrInsufficientFee},
+		{"genesis", s.ctx.WithBlockHeight(0), nil, 100, 0, nil},
+		{"unset params", unsetParamsCtx, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), 100, 0, sdkerrors.ErrLogic},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			fee, priority, err := s.feemarketKeeper.CheckTxFee(tc.ctx, newTx(tc.fee, tc.gas))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.expPriority, priority)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params.TargetBlockGas = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.feemarketKeeper.GetAuthority(), Params: params})
+	s.Require().Error(err)
+
+	params.TargetBlockGas = 42
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.feemarketKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.feemarketKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(types.DefaultParams(), sdk.NewDec(3))
+	s.feemarketKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.feemarketKeeper.ExportGenesis(s.ctx))
+}
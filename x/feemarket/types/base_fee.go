@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block following a block with the
+// given base fee and gas used, like in EIP-1559. The base fee changes by
+// MaxChangeRate times the relative difference between the gas used and
+// TargetBlockGas, capped to MaxChangeRate, and never falls below MinBaseFee.
+// After a block over the target, the base fee increases by at least the
+// smallest decimal, so that a zero base fee can grow.
+func NextBaseFee(params Params, baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	target := sdk.NewDecFromInt(math.NewIntFromUint64(params.TargetBlockGas))
+	ratio := sdk.NewDecFromInt(math.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	if ratio.GT(sdk.OneDec()) {
+		ratio = sdk.OneDec()
+	}
+
+	delta := baseFee.Mul(params.MaxChangeRate).Mul(ratio)
+	if gasUsed > params.TargetBlockGas && delta.LT(sdk.SmallestDec()) {
+		delta = sdk.SmallestDec()
+	}
+
+	return sdk.MaxDec(baseFee.Add(delta), params.MinBaseFee.Amount)
+}
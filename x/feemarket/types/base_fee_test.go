@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(1000, sdk.NewDecWithPrec(125, 3), sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+	testCases := []struct {
+		name     string
+		baseFee  sdk.Dec
+		gasUsed  uint64
+		expected sdk.Dec
+	}{
+		{"at target", sdk.NewDec(8), 1000, sdk.NewDec(8)},
+		{"twice the target", sdk.NewDec(8), 2000, sdk.NewDec(9)},
+		{"over twice the target", sdk.NewDec(8), 5000, sdk.NewDec(9)},
+		{"half the target", sdk.NewDec(8), 500, sdk.NewDecWithPrec(75, 1)},
+		{"no gas", sdk.NewDec(8), 0, sdk.NewDec(7)},
+		{"floor", sdk.NewDecWithPrec(1, 1), 0, sdk.NewDecWithPrec(1, 1)},
+		{"zero base fee grows", sdk.ZeroDec(), 1500, sdk.SmallestDec()},
+	}
+
+	for _, tc := range testCases {
+		if tc.name == "zero base fee grows" {
+			params.MinBaseFee.Amount = sdk.ZeroDec()
+		}
+		require.Equal(t, tc.expected, types.NextBaseFee(params, tc.baseFee, tc.gasUsed), tc.name)
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxChangeRate = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+	params.MaxChangeRate = sdk.NewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = sdk.DecCoin{Denom: "stake", Amount: sdk.NewDec(-1)}
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(targetBlockGas uint64, maxChangeRate sdk.Dec, minBaseFee sdk.DecCoin) Params {
+	return Params{
+		TargetBlockGas: targetBlockGas,
+		MaxChangeRate:  maxChangeRate,
+		MinBaseFee:     minBaseFee,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		TargetBlockGas: 15_000_000,
+		MaxChangeRate:  sdk.NewDecWithPrec(125, 3),
+		MinBaseFee:     sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("max change rate must be in (0, 1], got %s", p.MaxChangeRate)
+	}
+	if err := p.MinBaseFee.Validate(); err != nil {
+		return fmt.Errorf("invalid min base fee: %w", err)
+	}
+
+	return nil
+}
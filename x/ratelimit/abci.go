@@ -0,0 +1,18 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+// BeginBlocker prunes the counts of the messages of the blocks out of the
+// windows of the limits.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.PruneCounts(ctx)
+}
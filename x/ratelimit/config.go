@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+// FlagCheckTxLimits is the app config key of the limits enforced in CheckTx
+// only, in the format of types.ParseLimit.
+const FlagCheckTxLimits = "ratelimit.check-tx-limits"
+
+// Config defines the app config of the ratelimit module.
+type Config struct {
+	// CheckTxLimits are the limits enforced in CheckTx only, protecting the
+	// mempool of the node, in the format of types.ParseLimit.
+	CheckTxLimits []string `mapstructure:"check-tx-limits"`
+}
+
+// DefaultConfigTemplate is the app.toml template of Config.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Rate Limit Configuration                        ###
+###############################################################################
+
+[ratelimit]
+
+# check-tx-limits are the rate limits of the messages enforced in CheckTx only,
+# protecting the mempool of the node, in addition to the limits of the
+# ratelimit params enforced in CheckTx and DeliverTx. A limit has the format
+# <signer|global>:<msg type url|*>:<max msgs>:<window blocks>, e.g.
+# "signer:/cosmos.bank.v1beta1.MsgSend:10:5" for at most 10 MsgSend per signer
+# over 5 blocks.
+check-tx-limits = [{{ range $i, $limit := .RateLimit.CheckTxLimits }}{{ if $i }}, {{ end }}{{ printf "%q" $limit }}{{ end }}]
+`
+
+// CheckTxLimitsFromAppOpts returns the limits enforced in CheckTx only, read
+// from the app options.
+func CheckTxLimitsFromAppOpts(appOpts servertypes.AppOptions) ([]types.Limit, error) {
+	return types.ParseLimits(cast.ToStringSlice(appOpts.Get(FlagCheckTxLimits)))
+}
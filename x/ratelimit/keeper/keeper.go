@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+// Keeper of the ratelimit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	memKey   storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// checkTxLimits are the limits enforced in CheckTx only, counted in the
+	// memory store, local to the node.
+	checkTxLimits []types.Limit
+}
+
+// NewKeeper creates a new ratelimit Keeper instance. The checkTxLimits are
+// enforced in CheckTx only, in addition to the limits of the params enforced in
+// CheckTx and DeliverTx. They are usually read from the app config, and must be
+// valid. memKey must be the key of a memory store.
+func NewKeeper(cdc codec.BinaryCodec, key, memKey storetypes.StoreKey, authority string, checkTxLimits []types.Limit) Keeper {
+	if err := types.ValidateLimits(checkTxLimits); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		memKey:        memKey,
+		authority:     authority,
+		checkTxLimits: checkTxLimits,
+	}
+}
+
+// GetAuthority returns the x/ratelimit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/ratelimit module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/ratelimit module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetCheckTxLimits returns the limits enforced in CheckTx only.
+func (k Keeper) GetCheckTxLimits() []types.Limit {
+	return k.checkTxLimits
+}
+
+// ConsumeRateLimits counts msgs against the rate limits and returns an
+// ErrRateLimited error, counting none of them, if a limit is exceeded.
+//
+// The limits of the params are counted in the module store. In CheckTx, the
+// CheckTx limits are counted too, in the memory store, and the messages of
+// DeliverTx are counted against them without being checked, so that the txs
+// already in a block or in the mempool count towards them. The counts are
+// written to the store of ctx, so that they are discarded with the state of a
+// tx rejected by the AnteHandler or the mempool.
+func (k Keeper) ConsumeRateLimits(ctx sdk.Context, msgs []sdk.Msg) error {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	counts := tally(params.Limits, msgs)
+	store := ctx.KVStore(k.storeKey)
+	if err := check(store, counts, height); err != nil {
+		return err
+	}
+
+	// The memory store is local to the node, so it does not consume gas.
+	memStore := ctx.MultiStore().GetKVStore(k.memKey)
+	checkTxCounts := tally(k.checkTxLimits, msgs)
+	if ctx.IsCheckTx() {
+		if err := check(memStore, checkTxCounts, height); err != nil {
+			return err
+		}
+	}
+
+	add(store, counts, height)
+	add(memStore, checkTxCounts, height)
+
+	return nil
+}
+
+// PruneCounts deletes the counts of the blocks out of the windows of all the
+// limits of the params, and of the CheckTx limits.
+func (k Keeper) PruneCounts(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	prune(ctx.KVStore(k.storeKey), types.MaxWindowBlocks(k.GetParams(ctx).Limits), height)
+	prune(ctx.MultiStore().GetKVStore(k.memKey), types.MaxWindowBlocks(k.checkTxLimits), height)
+}
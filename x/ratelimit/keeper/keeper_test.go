@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+
+	sendURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ratelimitKeeper keeper.Keeper
+	key             *storetypes.KVStoreKey
+	memKey          *storetypes.MemoryStoreKey
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(ratelimit.AppModuleBasic{})
+	s.key = storetypes.NewKVStoreKey(types.StoreKey)
+	s.memKey = storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(s.key, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(s.memKey, storetypes.StoreTypeMemory, nil)
+	s.Require().NoError(cms.LoadLatestVersion())
+	s.ctx = sdk.NewContext(cms, cmtproto.Header{Height: 1}, false, log.NewNopLogger())
+
+	checkTxLimits := []types.Limit{types.NewLimit(types.AnyMsgTypeURL, true, 3, 1)}
+	s.ratelimitKeeper = keeper.NewKeeper(encCfg.Codec, s.key, s.memKey, authtypes.NewModuleAddress(govtypes.ModuleName).String(), checkTxLimits)
+	s.msgServer = keeper.NewMsgServerImpl(s.ratelimitKeeper)
+
+	params := types.NewParams(
+		types.NewLimit(sendURL, true, 2, 3),
+		types.NewLimit(types.AnyMsgTypeURL, false, 5, 2),
+	)
+	s.Require().NoError(s.ratelimitKeeper.SetParams(s.ctx, params))
+}
+
+func send(from sdk.AccAddress) sdk.Msg {
+	return banktypes.NewMsgSend(from, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+}
+
+func (s *KeeperTestSuite) TestConsumeRateLimitsDeliverTx() {
+	k, ctx := s.ratelimitKeeper, s.ctx
+
+	// two sends per signer over 3 blocks
+	s.Require().NoError(k.ConsumeRateLimits(ctx, []sdk.Msg{send(alice)}))
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(2), []sdk.Msg{send(alice), send(bob)}))
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx.WithBlockHeight(3), []sdk.Msg{send(alice)}), types.ErrRateLimited)
+
+	// a rejected tx counts none of its msgs
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx.WithBlockHeight(3), []sdk.Msg{send(bob), send(bob)}), types.ErrRateLimited)
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(3), []sdk.Msg{send(bob)}))
+
+	// the send of alice at height 1 leaves the window
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(4), []sdk.Msg{send(alice)}))
+
+	// five msgs of any type over 2 blocks
+	ctx = ctx.WithBlockHeight(10)
+	s.Require().NoError(k.ConsumeRateLimits(ctx, []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(bob), send(alice)}))
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(11), []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(bob)}))
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx.WithBlockHeight(11), []sdk.Msg{testdata.NewTestMsg(alice)}), types.ErrRateLimited)
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(12), []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+
+	// the CheckTx limits are not enforced in DeliverTx
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(20), []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+}
+
+func (s *KeeperTestSuite) TestConsumeRateLimitsCheckTx() {
+	k := s.ratelimitKeeper
+	// the CheckTx state is a branch of the committed state
+	ctx := s.ctx.WithIsCheckTx(true).WithMultiStore(s.ctx.MultiStore().CacheMultiStore())
+
+	// the CheckTx limit of three msgs per signer per block
+	s.Require().NoError(k.ConsumeRateLimits(ctx, []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx, []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}), types.ErrRateLimited)
+	s.Require().NoError(k.ConsumeRateLimits(ctx, []sdk.Msg{testdata.NewTestMsg(bob)}))
+
+	// the limits of the params are enforced too
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(2), []sdk.Msg{testdata.NewTestMsg(bob), testdata.NewTestMsg(bob)}))
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx.WithBlockHeight(2), []sdk.Msg{testdata.NewTestMsg(alice)}), types.ErrRateLimited)
+
+	// the counts of a branch discarded, as for a tx rejected by the
+	// AnteHandler or the mempool, are discarded too
+	branch := ctx.WithBlockHeight(5).WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	s.Require().NoError(k.ConsumeRateLimits(branch, []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(5), []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+
+	// the msgs of DeliverTx count towards the CheckTx limits without being
+	// limited by them
+	s.Require().NoError(k.ConsumeRateLimits(s.ctx.WithBlockHeight(10), []sdk.Msg{testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice), testdata.NewTestMsg(alice)}))
+	ctx = s.ctx.WithIsCheckTx(true).WithMultiStore(s.ctx.MultiStore().CacheMultiStore())
+	s.Require().ErrorIs(k.ConsumeRateLimits(ctx.WithBlockHeight(10), []sdk.Msg{testdata.NewTestMsg(alice)}), types.ErrRateLimited)
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(10), []sdk.Msg{testdata.NewTestMsg(bob)}))
+}
+
+func (s *KeeperTestSuite) TestPruneCounts() {
+	k, ctx := s.ratelimitKeeper, s.ctx
+
+	countsLen := func(key storetypes.StoreKey) int {
+		it := storetypes.KVStorePrefixIterator(ctx.MultiStore().GetKVStore(key), types.CountKeyPrefix)
+		defer it.Close()
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		return n
+	}
+
+	s.Require().NoError(k.ConsumeRateLimits(ctx, []sdk.Msg{send(alice)}))
+	s.Require().NoError(k.ConsumeRateLimits(ctx.WithBlockHeight(2), []sdk.Msg{send(bob)}))
+	// the send limit of alice and bob, and the global limit at both heights
+	s.Require().Equal(4, countsLen(s.key))
+	// the CheckTx limit of alice and bob
+	s.Require().Equal(2, countsLen(s.memKey))
+
+	// the largest window is 3 blocks, and 1 block for the CheckTx limits
+	k.PruneCounts(ctx.WithBlockHeight(2))
+	s.Require().Equal(4, countsLen(s.key))
+	s.Require().Equal(1, countsLen(s.memKey))
+	k.PruneCounts(ctx.WithBlockHeight(3))
+	s.Require().Equal(4, countsLen(s.key))
+	s.Require().Zero(countsLen(s.memKey))
+	k.PruneCounts(ctx.WithBlockHeight(4))
+	s.Require().Equal(2, countsLen(s.key))
+	k.PruneCounts(ctx.WithBlockHeight(5))
+	s.Require().Zero(countsLen(s.key))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(types.NewLimit(sendURL, false, 100, 10))
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: alice.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := types.NewParams(types.NewLimit(sendURL, false, 100, 10), types.NewLimit(sendURL, false, 10, 1))
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.ratelimitKeeper.GetAuthority(), Params: invalid})
+	s.Require().ErrorContains(err, "duplicate limit")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.ratelimitKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.ratelimitKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
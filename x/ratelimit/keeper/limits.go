@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+// count is the number of messages of a tx counted by a limit for a subject, a
+// signer or nil for a global limit.
+type count struct {
+	limit   types.Limit
+	subject []byte
+	n       uint64
+}
+
+// tally returns the numbers of msgs counted by limits, in a deterministic
+// order, since they are checked and added with gas in DeliverTx.
+func tally(limits []types.Limit, msgs []sdk.Msg) []*count {
+	var counts []*count
+	index := make(map[string]*count)
+	inc := func(i int, subject []byte) {
+		key := fmt.Sprintf("%d/%x", i, subject)
+		c, ok := index[key]
+		if !ok {
+			c = &count{limit: limits[i], subject: subject}
+			index[key] = c
+			counts = append(counts, c)
+		}
+		c.n++
+	}
+
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		for i, limit := range limits {
+			if !limit.Matches(typeURL) {
+				continue
+			}
+			if !limit.PerSigner {
+				inc(i, nil)
+				continue
+			}
+
+			seen := make(map[string]bool)
+			for _, signer := range msg.GetSigners() {
+				if !seen[string(signer)] {
+					seen[string(signer)] = true
+					inc(i, signer)
+				}
+			}
+		}
+	}
+
+	return counts
+}
+
+// check returns an ErrRateLimited error if one of counts exceeds its limit in
+// store, in the block at height.
+func check(store storetypes.KVStore, counts []*count, height int64) error {
+	for _, c := range counts {
+		used := windowCount(store, c.limit, c.subject, height)
+		if used+c.n > c.limit.MaxMsgs {
+			if c.limit.PerSigner {
+				return types.ErrRateLimited.Wrapf("%s: at most %d messages per signer over %d blocks, signer %s", c.limit.MsgTypeUrl, c.limit.MaxMsgs, c.limit.WindowBlocks, sdk.AccAddress(c.subject))
+			}
+			return types.ErrRateLimited.Wrapf("%s: at most %d messages over %d blocks", c.limit.MsgTypeUrl, c.limit.MaxMsgs, c.limit.WindowBlocks)
+		}
+	}
+
+	return nil
+}
+
+// add adds counts to store, in the block at height.
+func add(store storetypes.KVStore, counts []*count, height int64) {
+	for _, c := range counts {
+		key := types.CountKey(c.limit.MsgTypeUrl, c.subject, height)
+		store.Set(key, binary.BigEndian.AppendUint64(nil, getCount(store, key)+c.n))
+		store.Set(types.HeightIndexKey(height, key), []byte{})
+	}
+}
+
+// windowCount returns the number of messages counted by limit for subject in
+// its window ending at height.
+func windowCount(store storetypes.KVStore, limit types.Limit, subject []byte, height int64) uint64 {
+	start := height - int64(limit.WindowBlocks) + 1
+	if start < 0 {
+		start = 0
+	}
+
+	it := store.Iterator(
+		types.CountKey(limit.MsgTypeUrl, subject, start),
+		types.CountKey(limit.MsgTypeUrl, subject, height+1),
+	)
+	defer it.Close()
+
+	var total uint64
+	for ; it.Valid(); it.Next() {
+		total += binary.BigEndian.Uint64(it.Value())
+	}
+
+	return total
+}
+
+func getCount(store storetypes.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// prune deletes the counts of the blocks before the window of maxWindowBlocks
+// blocks ending at height.
+func prune(store storetypes.KVStore, maxWindowBlocks uint64, height int64) {
+	end := height - int64(maxWindowBlocks) + 1
+	if end <= 0 {
+		return
+	}
+
+	it := store.Iterator(types.HeightIndexKeyPrefix, types.HeightIndexPrefix(end))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		countKey := append(append([]byte{}, types.CountKeyPrefix...), key[len(types.HeightIndexKeyPrefix)+8:]...)
+		store.Delete(countKey)
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key, holding the counts of the
+	// CheckTx limits
+	MemStoreKey = "mem_ratelimit"
+)
+
+var (
+	ParamsKey = []byte{0x00}
+
+	// CountKeyPrefix is the prefix of the number of messages of a block counted
+	// for a subject, a signer or none for the global limits:
+	// 0x01 | len(msgTypeURL) | msgTypeURL | len(subject) | subject | height -> uint64
+	CountKeyPrefix = []byte{0x01}
+
+	// HeightIndexKeyPrefix is the prefix of the index of the counts by height,
+	// used to prune them: 0x02 | height | count key without prefix -> []byte{}
+	HeightIndexKeyPrefix = []byte{0x02}
+)
+
+// SubjectCountsKey returns the prefix of the counts of the messages of type
+// msgTypeURL, "*" for all the messages, for subject.
+func SubjectCountsKey(msgTypeURL string, subject []byte) []byte {
+	key := make([]byte, 0, len(CountKeyPrefix)+2+len(msgTypeURL)+len(subject))
+	key = append(key, CountKeyPrefix...)
+	key = append(key, byte(len(msgTypeURL)))
+	key = append(key, msgTypeURL...)
+	key = append(key, byte(len(subject)))
+	key = append(key, subject...)
+
+	return key
+}
+
+// CountKey returns the key of the count of the messages of type msgTypeURL for
+// subject in the block at height.
+func CountKey(msgTypeURL string, subject []byte, height int64) []byte {
+	return append(SubjectCountsKey(msgTypeURL, subject), HeightBytes(height)...)
+}
+
+// HeightIndexKey returns the key indexing the count of countKey by its height.
+func HeightIndexKey(height int64, countKey []byte) []byte {
+	key := append(HeightIndexPrefix(height), countKey[len(CountKeyPrefix):]...)
+	return key
+}
+
+// HeightIndexPrefix returns the prefix of the index of the counts of the block
+// at height.
+func HeightIndexPrefix(height int64) []byte {
+	return append(append([]byte{}, HeightIndexKeyPrefix...), HeightBytes(height)...)
+}
+
+// HeightBytes returns the big-endian encoding of height.
+func HeightBytes(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
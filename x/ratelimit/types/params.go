@@ -0,0 +1,148 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AnyMsgTypeURL is the Limit.MsgTypeUrl counting all the messages.
+const AnyMsgTypeURL = "*"
+
+// NewParams returns Params instance with the given limits.
+func NewParams(limits ...Limit) Params {
+	return Params{Limits: limits}
+}
+
+// DefaultParams returns default x/ratelimit module parameters, with no limits.
+func DefaultParams() Params {
+	return Params{Limits: []Limit{}}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	return ValidateLimits(p.Limits)
+}
+
+// MaxWindowBlocks returns the largest window of the limits, 0 if there are no
+// limits.
+func MaxWindowBlocks(limits []Limit) uint64 {
+	var max uint64
+	for _, limit := range limits {
+		if limit.WindowBlocks > max {
+			max = limit.WindowBlocks
+		}
+	}
+
+	return max
+}
+
+// ValidateLimits validates each limit and checks that no two limits count the
+// same messages for the same subjects.
+func ValidateLimits(limits []Limit) error {
+	seen := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%t", limit.MsgTypeUrl, limit.PerSigner)
+		if seen[key] {
+			return fmt.Errorf("duplicate limit %s", limit.Format())
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// NewLimit returns a Limit of maxMsgs messages of type msgTypeURL, "*" for all
+// the messages, over windowBlocks blocks.
+func NewLimit(msgTypeURL string, perSigner bool, maxMsgs, windowBlocks uint64) Limit {
+	return Limit{
+		MsgTypeUrl:   msgTypeURL,
+		PerSigner:    perSigner,
+		MaxMsgs:      maxMsgs,
+		WindowBlocks: windowBlocks,
+	}
+}
+
+// Validate does the sanity check on the limit.
+func (l Limit) Validate() error {
+	switch {
+	case l.MsgTypeUrl == "":
+		return errors.New("limit msg type url cannot be empty")
+	case len(l.MsgTypeUrl) > 255:
+		return fmt.Errorf("limit msg type url %s is too long", l.MsgTypeUrl)
+	case l.MsgTypeUrl != AnyMsgTypeURL && !strings.HasPrefix(l.MsgTypeUrl, "/"):
+		return fmt.Errorf("limit msg type url %s must start with /", l.MsgTypeUrl)
+	case l.WindowBlocks == 0:
+		return fmt.Errorf("limit %s window must be positive", l.Format())
+	}
+
+	return nil
+}
+
+// Matches returns whether the limit counts the messages of type msgTypeURL.
+func (l Limit) Matches(msgTypeURL string) bool {
+	return l.MsgTypeUrl == AnyMsgTypeURL || l.MsgTypeUrl == msgTypeURL
+}
+
+// Format returns the limit in the format parsed by ParseLimit.
+func (l Limit) Format() string {
+	scope := "global"
+	if l.PerSigner {
+		scope = "signer"
+	}
+
+	return fmt.Sprintf("%s:%s:%d:%d", scope, l.MsgTypeUrl, l.MaxMsgs, l.WindowBlocks)
+}
+
+// ParseLimit parses a limit in the format
+//
+//	<signer|global>:<msg type url|*>:<max msgs>:<window blocks>
+//
+// e.g. "signer:/cosmos.bank.v1beta1.MsgSend:10:5" for at most 10 MsgSend per
+// signer over 5 blocks.
+func ParseLimit(s string) (Limit, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 4 {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected <signer|global>:<msg type url|*>:<max msgs>:<window blocks>", s)
+	}
+
+	var limit Limit
+	switch parts[0] {
+	case "signer":
+		limit.PerSigner = true
+	case "global":
+	default:
+		return Limit{}, fmt.Errorf("invalid limit %q scope %q, expected signer or global", s, parts[0])
+	}
+
+	limit.MsgTypeUrl = parts[1]
+
+	var err error
+	if limit.MaxMsgs, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return Limit{}, fmt.Errorf("invalid limit %q max msgs: %w", s, err)
+	}
+	if limit.WindowBlocks, err = strconv.ParseUint(parts[3], 10, 64); err != nil {
+		return Limit{}, fmt.Errorf("invalid limit %q window blocks: %w", s, err)
+	}
+
+	return limit, limit.Validate()
+}
+
+// ParseLimits parses limits in the format of ParseLimit and validates them.
+func ParseLimits(ss []string) ([]Limit, error) {
+	limits := make([]Limit, len(ss))
+	for i, s := range ss {
+		limit, err := ParseLimit(s)
+		if err != nil {
+			return nil, err
+		}
+		limits[i] = limit
+	}
+
+	return limits, ValidateLimits(limits)
+}
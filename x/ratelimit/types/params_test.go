@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/types"
+)
+
+func TestParseLimit(t *testing.T) {
+	testCases := []struct {
+		s      string
+		limit  types.Limit
+		expErr string
+	}{
+		{"signer:/cosmos.bank.v1beta1.MsgSend:10:5", types.NewLimit("/cosmos.bank.v1beta1.MsgSend", true, 10, 5), ""},
+		{"global:*:1000:1", types.NewLimit(types.AnyMsgTypeURL, false, 1000, 1), ""},
+		{"global:*:0:1", types.NewLimit(types.AnyMsgTypeURL, false, 0, 1), ""},
+		{"signer:*:10", types.Limit{}, "expected"},
+		{"account:*:10:5", types.Limit{}, "scope"},
+		{"signer:cosmos.bank.v1beta1.MsgSend:10:5", types.Limit{}, "must start with /"},
+		{"signer::10:5", types.Limit{}, "cannot be empty"},
+		{"signer:*:-1:5", types.Limit{}, "max msgs"},
+		{"signer:*:10:0", types.Limit{}, "window must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			limit, err := types.ParseLimit(tc.s)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.limit, limit)
+			require.Equal(t, tc.s, limit.Format())
+		})
+	}
+
+	_, err := types.ParseLimits([]string{"signer:*:10:5", "signer:*:1:1"})
+	require.ErrorContains(t, err, "duplicate limit")
+
+	limits, err := types.ParseLimits([]string{"signer:*:10:5", "global:*:1:7"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), types.MaxWindowBlocks(limits))
+}